@@ -0,0 +1,353 @@
+package i18n
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidAppleStrings is returned when a `.strings` or `.stringsdict` file cannot be parsed.
+var ErrInvalidAppleStrings = errors.New("i18n: invalid apple strings file")
+
+// UnmarshalStrings is an `Unmarshaler` for the Apple `.strings` files (`"key" = "value";`),
+// to be used with `WithUnmarshaler`.
+func UnmarshalStrings(data []byte, v any) error {
+	out, ok := v.(*map[string]string)
+	if !ok {
+		return fmt.Errorf("%w: unsupported target %T", ErrInvalidAppleStrings, v)
+	}
+	if *out == nil {
+		*out = make(map[string]string)
+	}
+
+	s := &stringsScanner{input: []rune(strings.TrimPrefix(string(data), "\ufeff"))}
+	for {
+		s.skipBlank()
+		if s.pos >= len(s.input) {
+			return nil
+		}
+		key, err := s.token()
+		if err != nil {
+			return err
+		}
+		s.skipBlank()
+		if !s.accept('=') {
+			return s.errorf("missing =")
+		}
+		s.skipBlank()
+		value, err := s.token()
+		if err != nil {
+			return err
+		}
+		s.skipBlank()
+		if !s.accept(';') {
+			return s.errorf("missing ;")
+		}
+		(*out)[key] = value
+	}
+}
+
+// stringsScanner scans the `.strings` files.
+type stringsScanner struct {
+	input []rune
+	pos   int
+}
+
+// errorf
+func (s *stringsScanner) errorf(format string, args ...any) error {
+	line := 1 + strings.Count(string(s.input[:min(s.pos, len(s.input))]), "\n")
+	return fmt.Errorf("%w: %s at line %d", ErrInvalidAppleStrings, fmt.Sprintf(format, args...), line)
+}
+
+// accept consumes the rune if it's next.
+func (s *stringsScanner) accept(r rune) bool {
+	if s.pos < len(s.input) && s.input[s.pos] == r {
+		s.pos++
+		return true
+	}
+	return false
+}
+
+// skipBlank skips the spaces and the comments.
+func (s *stringsScanner) skipBlank() {
+	for s.pos < len(s.input) {
+		rest := string(s.input[s.pos:min(s.pos+2, len(s.input))])
+		switch {
+		case strings.ContainsRune(" \t\r\n", s.input[s.pos]):
+			s.pos++
+		case rest == "//":
+			for s.pos < len(s.input) && s.input[s.pos] != '\n' {
+				s.pos++
+			}
+		case rest == "/*":
+			s.pos += 2
+			for s.pos < len(s.input) && string(s.input[s.pos:min(s.pos+2, len(s.input))]) != "*/" {
+				s.pos++
+			}
+			s.pos = min(s.pos+2, len(s.input))
+		default:
+			return
+		}
+	}
+}
+
+// token reads a quoted string, or an unquoted word.
+func (s *stringsScanner) token() (string, error) {
+	if s.pos >= len(s.input) {
+		return "", s.errorf("unexpected end")
+	}
+	if s.input[s.pos] != '"' {
+		start := s.pos
+		for s.pos < len(s.input) && !strings.ContainsRune(" \t\r\n=;\"", s.input[s.pos]) {
+			s.pos++
+		}
+		if start == s.pos {
+			return "", s.errorf("unexpected %q", s.input[s.pos])
+		}
+		return string(s.input[start:s.pos]), nil
+	}
+
+	s.pos++
+	var b strings.Builder
+	for s.pos < len(s.input) {
+		c := s.input[s.pos]
+		s.pos++
+		switch {
+		case c == '"':
+			return b.String(), nil
+		case c == '\\' && s.pos < len(s.input):
+			e := s.input[s.pos]
+			s.pos++
+			switch e {
+			case 'n':
+				b.WriteByte('\n')
+			case 't':
+				b.WriteByte('\t')
+			case 'r':
+				b.WriteByte('\r')
+			case 'U', 'u':
+				if s.pos+4 > len(s.input) {
+					return "", s.errorf("invalid unicode escape")
+				}
+				r, err := strconv.ParseUint(string(s.input[s.pos:s.pos+4]), 16, 32)
+				if err != nil {
+					return "", s.errorf("invalid unicode escape")
+				}
+				b.WriteRune(rune(r))
+				s.pos += 4
+			default:
+				b.WriteRune(e)
+			}
+		default:
+			b.WriteRune(c)
+		}
+	}
+	return "", s.errorf("unterminated string")
+}
+
+// stringsdictVariable matches the variables of a `NSStringLocalizedFormatKey` like `%#@files@` or `%1$#@files@`.
+var stringsdictVariable = regexp.MustCompile(`%(?:(\d+)\$)?#@([^@]+)@`)
+
+// stringsdictSpecifier matches the format specifiers like `%d`, `%lld` or `%1$@`, and the escaped `%%`.
+var stringsdictSpecifier = regexp.MustCompile(`%%|%(?:(\d+)\$)?(?:ll|l|hh|h|q|z|t|j)?([dDiuUxXoOfeEgGcCsSp@])`)
+
+// UnmarshalStringsdict is an `Unmarshaler` for the Apple `.stringsdict` files (plural rule dictionaries),
+// to be used with `WithUnmarshaler`. The variables of a `NSStringLocalizedFormatKey` are converted to ICU
+// `plural` arguments named after the variables: the specifier of the number in the forms (`%d`, or the type of
+// `NSStringFormatValueTypeKey`) is replaced by `#`, and `zero` is mapped to `=0` like iOS applies it to 0 in every
+// language. The rest of the text is kept as is, so it can use ICU arguments like `{folder}`; the other specifiers
+// like `%@` have no name to become an ICU argument, so a file using them is rejected.
+func UnmarshalStringsdict(data []byte, v any) error {
+	out, ok := v.(*map[string]string)
+	if !ok {
+		return fmt.Errorf("%w: unsupported target %T", ErrInvalidAppleStrings, v)
+	}
+	if *out == nil {
+		*out = make(map[string]string)
+	}
+
+	root, err := parsePlist(xml.NewDecoder(strings.NewReader(string(data))))
+	if err != nil {
+		return err
+	}
+	entries, ok := root.(*plistDict)
+	if !ok {
+		return fmt.Errorf("%w: the root is not a dict", ErrInvalidAppleStrings)
+	}
+	for _, key := range entries.keys {
+		entry, ok := entries.values[key].(*plistDict)
+		if !ok {
+			return fmt.Errorf("%w: %q is not a dict", ErrInvalidAppleStrings, key)
+		}
+		text, err := stringsdictICU(entry)
+		if err != nil {
+			return fmt.Errorf("%w: %q: %v", ErrInvalidAppleStrings, key, err)
+		}
+		(*out)[key] = text
+	}
+	return nil
+}
+
+// stringsdictICU converts an entry of a `.stringsdict` file to ICU MessageFormat.
+func stringsdictICU(entry *plistDict) (string, error) {
+	format, ok := entry.values["NSStringLocalizedFormatKey"].(string)
+	if !ok {
+		return "", errors.New("missing NSStringLocalizedFormatKey")
+	}
+
+	var b strings.Builder
+	last := 0
+	for _, loc := range stringsdictVariable.FindAllStringSubmatchIndex(format, -1) {
+		text, err := stringsdictText(format[last:loc[0]], nil)
+		if err != nil {
+			return "", err
+		}
+		b.WriteString(text)
+		last = loc[1]
+
+		name := format[loc[4]:loc[5]]
+		forms, ok := entry.values[name].(*plistDict)
+		if !ok {
+			return "", fmt.Errorf("missing variable %q", name)
+		}
+		count := stringsdictCount(forms, stringsdictPosition(format, loc[2], loc[3]))
+		b.WriteString("{" + name + ", plural,")
+		for _, category := range cldrCategoryOrder {
+			form, ok := forms.values[category].(string)
+			if !ok {
+				continue
+			}
+			key := category
+			if category == "zero" {
+				key = "=0"
+			}
+			text, err := stringsdictText(form, count)
+			if err != nil {
+				return "", fmt.Errorf("%s form of %q: %v", category, name, err)
+			}
+			b.WriteString(" " + key + " {" + text + "}")
+		}
+		if _, ok := forms.values["other"]; !ok {
+			return "", fmt.Errorf("missing other form of %q", name)
+		}
+		b.WriteString("}")
+	}
+	text, err := stringsdictText(format[last:], nil)
+	if err != nil {
+		return "", err
+	}
+	b.WriteString(text)
+	return b.String(), nil
+}
+
+// stringsdictText converts the specifiers of a text: `%%` to `%`, and the specifiers matched by count to `#`.
+// The other specifiers are an error.
+func stringsdictText(text string, count func(position, conversion string) bool) (string, error) {
+	var b strings.Builder
+	last := 0
+	for _, loc := range stringsdictSpecifier.FindAllStringSubmatchIndex(text, -1) {
+		b.WriteString(text[last:loc[0]])
+		last = loc[1]
+
+		switch specifier := text[loc[0]:loc[1]]; {
+		case specifier == "%%":
+			b.WriteString("%")
+		case count != nil && count(stringsdictPosition(text, loc[2], loc[3]), text[loc[4]:loc[5]]):
+			b.WriteString("#")
+		default:
+			return "", fmt.Errorf("unsupported format specifier %q", specifier)
+		}
+	}
+	b.WriteString(text[last:])
+	return b.String(), nil
+}
+
+// stringsdictCount returns the matcher of the specifiers of the number in the forms of a variable at the position,
+// empty if the variable has none: the specifiers at the same position with the conversion of
+// `NSStringFormatValueTypeKey`, or any number conversion without the key.
+func stringsdictCount(forms *plistDict, position string) func(position, conversion string) bool {
+	valueType, _ := forms.values["NSStringFormatValueTypeKey"].(string)
+	return func(p, conversion string) bool {
+		if p != "" && position != "" && p != position {
+			return false
+		}
+		if valueType != "" {
+			return strings.EqualFold(conversion, valueType[len(valueType)-1:])
+		}
+		return !strings.Contains("cCsSp@", conversion)
+	}
+}
+
+// stringsdictPosition returns the position of a submatch like `1` of `%1$d`, empty if it's not positional.
+func stringsdictPosition(text string, start, end int) string {
+	if start < 0 {
+		return ""
+	}
+	return text[start:end]
+}
+
+// plistDict is a `dict` of a property list, the keys are kept in order.
+type plistDict struct {
+	keys   []string
+	values map[string]any
+}
+
+// parsePlist parses the first value of a property list, only `dict` and `string` are supported,
+// the other values are kept as their text.
+func parsePlist(d *xml.Decoder) (any, error) {
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			return nil, fmt.Errorf("%w: no value", ErrInvalidAppleStrings)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidAppleStrings, err)
+		}
+		if start, ok := tok.(xml.StartElement); ok && start.Name.Local != "plist" {
+			return parsePlistValue(d, start)
+		}
+	}
+}
+
+// parsePlistValue parses the value of an element.
+func parsePlistValue(d *xml.Decoder, start xml.StartElement) (any, error) {
+	if start.Name.Local != "dict" {
+		var text string
+		if err := d.DecodeElement(&text, &start); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidAppleStrings, err)
+		}
+		return text, nil
+	}
+
+	dict := &plistDict{values: make(map[string]any)}
+	key := ""
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidAppleStrings, err)
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			if t.Name.Local == "key" {
+				if err := d.DecodeElement(&key, &t); err != nil {
+					return nil, fmt.Errorf("%w: %v", ErrInvalidAppleStrings, err)
+				}
+				continue
+			}
+			value, err := parsePlistValue(d, t)
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := dict.values[key]; !ok {
+				dict.keys = append(dict.keys, key)
+			}
+			dict.values[key] = value
+		case xml.EndElement:
+			return dict, nil
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package i18n
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnmarshalStrings(t *testing.T) {
+	assert := assert.New(t)
+
+	b, err := os.ReadFile("test/en.strings")
+	assert.NoError(err)
+	var messages map[string]string
+	assert.NoError(UnmarshalStrings(b, &messages))
+	assert.Equal(map[string]string{
+		"hello":        "Hello, world",
+		"hello_name":   "Hello, \"{name}\"\nWelcome",
+		"unquoted_key": "Unquoted",
+		"unicode":      "café",
+	}, messages)
+
+	for _, data := range []string{
+		`"a" "b";`,
+		`"a" = "b"`,
+		`"a" = "b`,
+		`= "b";`,
+	} {
+		assert.True(errors.Is(UnmarshalStrings([]byte(data), &messages), ErrInvalidAppleStrings), data)
+	}
+}
+
+func TestUnmarshalStringsdict(t *testing.T) {
+	assert := assert.New(t)
+
+	b, err := os.ReadFile("test/en.stringsdict")
+	assert.NoError(err)
+	var messages map[string]string
+	assert.NoError(UnmarshalStringsdict(b, &messages))
+	assert.Equal(map[string]string{
+		"files": "{count, plural, =0 {No files} one {# file} other {# files}} in {folder}",
+		"likes": "{people, plural, one {# person} other {# people}} liked {posts, plural, one {a post} other {# posts}}",
+	}, messages)
+
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithUnmarshaler(UnmarshalStringsdict),
+	)
+	assert.NoError(bundle.LoadFiles("test/en.stringsdict"))
+	localizer := bundle.NewLocalizer("en")
+	assert.Equal("No files in Documents", localizer.Get("files", Vars{"count": 0, "folder": "Documents"}))
+	assert.Equal("1 file in Documents", localizer.Get("files", Vars{"count": 1, "folder": "Documents"}))
+	assert.Equal("2 people liked a post", localizer.Get("likes", Vars{"people": 2, "posts": 1}))
+
+	assert.NoError(UnmarshalStringsdict([]byte(`<plist><dict><key>a</key><dict>`+
+		`<key>NSStringLocalizedFormatKey</key><string>%2$#@n@ in {folder}, 100%%</string>`+
+		`<key>n</key><dict><key>NSStringFormatValueTypeKey</key><string>lld</string>`+
+		`<key>one</key><string>%2$lld file</string><key>other</key><string>%2$d files (%%)</string></dict>`+
+		`</dict></dict></plist>`), &messages))
+	assert.Equal("{n, plural, one {# file} other {# files (%)}} in {folder}, 100%", messages["a"])
+
+	for _, data := range []string{
+		`<plist><string>a</string></plist>`,
+		`<plist><dict><key>a</key><dict><key>x</key><string>%#@n@</string></dict></dict></plist>`,
+		`<plist><dict><key>a</key><dict><key>NSStringLocalizedFormatKey</key><string>%#@n@</string></dict></dict></plist>`,
+		`<plist><dict><key>a</key><dict><key>NSStringLocalizedFormatKey</key><string>%#@n@</string><key>n</key><dict><key>one</key><string>1</string></dict></dict></dict></plist>`,
+		`<plist><dict>`,
+		`<plist><dict><key>a</key><dict><key>NSStringLocalizedFormatKey</key><string>%#@n@</string><key>n</key><dict><key>NSStringFormatValueTypeKey</key><string>d</string><key>other</key><string>%d files in %@</string></dict></dict></dict></plist>`,
+		`<plist><dict><key>a</key><dict><key>NSStringLocalizedFormatKey</key><string>%#@n@</string><key>n</key><dict><key>other</key><string>%1$d files in %2$@</string></dict></dict></dict></plist>`,
+		`<plist><dict><key>a</key><dict><key>NSStringLocalizedFormatKey</key><string>%1$#@n@ in %2$@</string><key>n</key><dict><key>other</key><string>%1$d files</string></dict></dict></dict></plist>`,
+	} {
+		assert.True(errors.Is(UnmarshalStringsdict([]byte(data), &messages), ErrInvalidAppleStrings), data)
+	}
+}
@@ -0,0 +1,118 @@
+package i18n
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+)
+
+// ErrInvalidArchive is returned when an archive is neither a zip nor a tar file.
+var ErrInvalidArchive = errors.New("i18n: invalid archive")
+
+// LoadArchive loads the translations from the files of a zip or a tar archive (optionally compressed by gzip
+// or zstd like `locales.tar.gz`), useful when the translations are shipped as a single artifact. The files
+// are named after their locale like `LoadFiles` does, the hidden files like `.DS_Store` are skipped.
+func (bundle *I18n) LoadArchive(file string) error {
+	b, err := os.ReadFile(file) //nolint:gosec
+	if err != nil {
+		return err
+	}
+	_, b, err = decompress(file, b)
+	if err != nil {
+		return err
+	}
+
+	var entries map[string][]byte
+	switch {
+	case bytes.HasPrefix(b, []byte("PK\x03\x04")):
+		entries, err = readZip(b)
+	case len(b) > 262 && string(b[257:262]) == "ustar":
+		entries, err = readTar(b)
+	default:
+		return fmt.Errorf("%w: %q", ErrInvalidArchive, file)
+	}
+	if err != nil {
+		return err
+	}
+
+	data := make(map[string]map[string]string)
+	for name, b := range entries {
+		trans, err := bundle.unmarshalMessages(name, b)
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		locale := nameInsenstive(name)
+		_, ok := data[locale]
+		if !ok {
+			data[locale] = make(map[string]string)
+		}
+		for name, text := range trans {
+			data[locale][name] = text
+		}
+	}
+	return bundle.LoadMessages(data)
+}
+
+// readZip returns the files of a zip archive.
+func readZip(b []byte) (map[string][]byte, error) {
+	r, err := zip.NewReader(bytes.NewReader(b), int64(len(b)))
+	if err != nil {
+		return nil, err
+	}
+	entries := make(map[string][]byte)
+	for _, f := range r.File {
+		if f.FileInfo().IsDir() || isHiddenEntry(f.Name) {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return nil, err
+		}
+		b, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			return nil, err
+		}
+		entries[f.Name] = b
+	}
+	return entries, nil
+}
+
+// readTar returns the files of a tar archive.
+func readTar(b []byte) (map[string][]byte, error) {
+	r := tar.NewReader(bytes.NewReader(b))
+	entries := make(map[string][]byte)
+	for {
+		header, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			return entries, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if header.Typeflag != tar.TypeReg || isHiddenEntry(header.Name) {
+			continue
+		}
+		b, err := io.ReadAll(r)
+		if err != nil {
+			return nil, err
+		}
+		entries[header.Name] = b
+	}
+}
+
+// isHiddenEntry reports whether an entry or one of its directories is hidden, like `__MACOSX/en.json`.
+func isHiddenEntry(name string) bool {
+	for _, part := range strings.Split(path.Clean(name), "/") {
+		if (strings.HasPrefix(part, ".") && part != "." && part != "..") || part == "__MACOSX" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,65 @@
+package i18n
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadArchive(t *testing.T) {
+	assert := assert.New(t)
+
+	files := map[string]string{
+		"locales/en.json":           `{"hello": "Hello"}`,
+		"locales/zh-Hans.yml":       "hello: 你好\n",
+		"locales/.DS_Store":         "\x00\x01",
+		"__MACOSX/locales/en.json":  "\x00\x01",
+		"locales/zh-Hans.more.json": `{"bye": "再见"}`,
+	}
+	dir := t.TempDir()
+
+	var zipped bytes.Buffer
+	zw := zip.NewWriter(&zipped)
+	for name, data := range files {
+		w, err := zw.Create(name)
+		assert.NoError(err)
+		_, err = w.Write([]byte(data))
+		assert.NoError(err)
+	}
+	assert.NoError(zw.Close())
+	assert.NoError(os.WriteFile(filepath.Join(dir, "locales.zip"), zipped.Bytes(), 0o600))
+
+	var tarred bytes.Buffer
+	gw := gzip.NewWriter(&tarred)
+	tw := tar.NewWriter(gw)
+	for name, data := range files {
+		assert.NoError(tw.WriteHeader(&tar.Header{Name: name, Mode: 0o600, Size: int64(len(data)), Typeflag: tar.TypeReg}))
+		_, err := tw.Write([]byte(data))
+		assert.NoError(err)
+	}
+	assert.NoError(tw.Close())
+	assert.NoError(gw.Close())
+	assert.NoError(os.WriteFile(filepath.Join(dir, "locales.tar.gz"), tarred.Bytes(), 0o600))
+
+	for _, archive := range []string{"locales.zip", "locales.tar.gz"} {
+		bundle := NewBundle(
+			WithDefaultLocale("en"),
+			WithLocales("en", "zh-Hans"),
+		)
+		assert.NoError(bundle.LoadArchive(filepath.Join(dir, archive)), archive)
+		assert.Equal("Hello", bundle.NewLocalizer("en").Get("hello"), archive)
+		localizer := bundle.NewLocalizer("zh-Hans")
+		assert.Equal("你好", localizer.Get("hello"), archive)
+		assert.Equal("再见", localizer.Get("bye"), archive)
+	}
+
+	bundle := NewBundle(WithDefaultLocale("en"))
+	assert.ErrorIs(bundle.LoadArchive("test/zh-Hans.json"), ErrInvalidArchive)
+	assert.Error(bundle.LoadArchive(filepath.Join(dir, "missing.zip")))
+}
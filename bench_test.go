@@ -0,0 +1,88 @@
+package i18n
+
+import (
+	"math/rand/v2"
+	"runtime"
+	"strconv"
+	"testing"
+)
+
+// The catalog layouts compared by the benchmarks: the nested maps of the bundle, and a flat map keyed by
+// locale and name. Run them with `go test -run '^$' -bench Catalog`.
+const (
+	benchLocales = 30
+	benchNames   = 50000
+)
+
+// flatKey is the key of the flat catalog.
+type flatKey struct {
+	locale string
+	name   string
+}
+
+// benchCatalogs returns the same translations in both layouts, with the locales and the names to look up.
+func benchCatalogs() (map[string]map[string]*parsedTranslation, map[flatKey]*parsedTranslation, []string, []string) {
+	locales := make([]string, benchLocales)
+	names := make([]string, benchNames)
+	for i := range locales {
+		locales[i] = "locale-" + strconv.Itoa(i)
+	}
+	for i := range names {
+		names[i] = "messages.section" + strconv.Itoa(i%100) + ".name" + strconv.Itoa(i)
+	}
+
+	nested := make(map[string]map[string]*parsedTranslation, len(locales))
+	flat := make(map[flatKey]*parsedTranslation, len(locales)*len(names))
+	for _, locale := range locales {
+		nested[locale] = make(map[string]*parsedTranslation, len(names))
+		for _, name := range names {
+			trans := &parsedTranslation{locale: locale, name: name, text: name, static: true}
+			nested[locale][name] = trans
+			flat[flatKey{locale, name}] = trans
+		}
+	}
+	return nested, flat, locales, names
+}
+
+func BenchmarkCatalogLookup(b *testing.B) {
+	nested, flat, locales, names := benchCatalogs()
+	r := rand.New(rand.NewPCG(1, 2))
+
+	b.Run("nested", func(b *testing.B) {
+		for range b.N {
+			if nested[locales[r.IntN(len(locales))]][names[r.IntN(len(names))]] == nil {
+				b.Fatal("missing translation")
+			}
+		}
+	})
+	b.Run("flat", func(b *testing.B) {
+		for range b.N {
+			if flat[flatKey{locales[r.IntN(len(locales))], names[r.IntN(len(names))]}] == nil {
+				b.Fatal("missing translation")
+			}
+		}
+	})
+}
+
+// BenchmarkCatalogGC measures a full GC cycle with the catalog of each layout alive, an op is a cycle.
+func BenchmarkCatalogGC(b *testing.B) {
+	b.Run("nested", func(b *testing.B) {
+		nested, _, _, _ := benchCatalogs()
+		benchGC(b)
+		runtime.KeepAlive(nested)
+	})
+	b.Run("flat", func(b *testing.B) {
+		_, flat, _, _ := benchCatalogs()
+		benchGC(b)
+		runtime.KeepAlive(flat)
+	})
+}
+
+// benchGC runs a full GC cycle per op.
+func benchGC(b *testing.B) {
+	runtime.GC()
+	b.ResetTimer()
+	for range b.N {
+		runtime.GC()
+	}
+}
@@ -0,0 +1,105 @@
+package i18n
+
+import (
+	"slices"
+
+	"golang.org/x/text/language"
+)
+
+// The Unicode marks isolating a text of any direction within a text of the opposite one.
+const (
+	firstStrongIsolate    = "\u2068"
+	popDirectionalIsolate = "\u2069"
+)
+
+// TextDirection is the direction of the text of a locale, the value of the `dir` attribute of HTML.
+type TextDirection string
+
+// The text directions.
+const (
+	LTR TextDirection = "ltr"
+	RTL TextDirection = "rtl"
+)
+
+// rtlScripts are the scripts written from right to left.
+var rtlScripts = map[string]bool{
+	"Adlm": true, "Arab": true, "Hebr": true, "Mand": true, "Nkoo": true,
+	"Rohg": true, "Samr": true, "Syrc": true, "Thaa": true,
+}
+
+// WithBidiIsolation wraps the text variables of the simple arguments like `{name}` in FSI and PDI marks
+// for the right-to-left locales like `ar` and `he`, so that the left-to-right texts given by the users
+// don't garble the order of the words around them. The variables of the `plural` and `select` arguments
+// are left as is, since they choose the text instead of being rendered.
+func WithBidiIsolation(enabled bool) func(*I18n) {
+	return func(bundle *I18n) {
+		bundle.bidiIsolation = enabled
+	}
+}
+
+// IsRTL reports whether the locale is written from right to left, like `ar`, `he` and `fa`,
+// by its script or the likely script of its language. The invalid locales are left to right.
+func IsRTL(locale string) bool {
+	tag, err := language.Parse(locale)
+	return err == nil && isRTL(tag)
+}
+
+// Direction returns the text direction of the localizer's locale, e.g. for the `dir` attribute of a page:
+//
+//	<html lang="{{.Locale}}" dir="{{.Direction}}">
+func (localizer *Localizer) Direction() TextDirection {
+	if isRTL(localizer.tag()) {
+		return RTL
+	}
+	return LTR
+}
+
+// isRTL reports whether the script of the locale, explicit or likely, is written from right to left.
+func isRTL(tag language.Tag) bool {
+	script, _ := tag.Script()
+	return rtlScripts[script.String()]
+}
+
+// isolatedArguments returns the names of the arguments that are only used as simple arguments in the message.
+func (t *parsedTranslation) isolatedArguments() []string {
+	t.isolatedOnce.Do(func() {
+		args, err := parseMessageArguments(t.text)
+		if err != nil {
+			return
+		}
+		typed := make(map[string]bool)
+		for _, arg := range args {
+			if arg.Type != "" {
+				typed[arg.Name] = true
+			}
+		}
+		for _, arg := range args {
+			if arg.Type == "" && !typed[arg.Name] && !slices.Contains(t.isolated, arg.Name) {
+				t.isolated = append(t.isolated, arg.Name)
+			}
+		}
+	})
+	return t.isolated
+}
+
+// isolateVars returns a copy of the data with the text values of the names wrapped in FSI and PDI marks.
+func isolateVars(data Vars, names []string) Vars {
+	var isolated Vars
+	for _, name := range names {
+		s, ok := data[name].(string)
+		if !ok || s == "" {
+			continue
+		}
+		if isolated == nil {
+			isolated = make(Vars, len(data))
+			for k, v := range data {
+				isolated[k] = v
+			}
+		}
+		isolated[name] = firstStrongIsolate + s + popDirectionalIsolate
+	}
+	if isolated == nil {
+		return data
+	}
+	return isolated
+}
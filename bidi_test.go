@@ -0,0 +1,49 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBidiIsolation(t *testing.T) {
+	assert := assert.New(t)
+
+	messages := map[string]map[string]string{
+		"en": {"welcome": "Welcome, {name}"},
+		"ar": {
+			"welcome": "مرحبا {name}",
+			"files":   "{user} has {count, plural, one {# file} other {# files}}",
+			"gender":  "{gender, select, female {هي} other {هو}} {gender}",
+		},
+	}
+	bundle := NewBundle(WithDefaultLocale("en"), WithLocales("en", "ar"), WithBidiIsolation(true))
+	assert.NoError(bundle.LoadMessages(messages))
+
+	assert.Equal("مرحبا \u2068Alice\u2069", bundle.NewLocalizer("ar").Get("welcome", Vars{"name": "Alice"}))
+	assert.Equal("\u2068Bob\u2069 has 2 files", bundle.NewLocalizer("ar").Get("files", Vars{"user": "Bob", "count": 2}))
+	assert.Equal("هي female", bundle.NewLocalizer("ar").Get("gender", Vars{"gender": "female"}))
+	assert.Equal("Welcome, Alice", bundle.NewLocalizer("en").Get("welcome", Vars{"name": "Alice"}))
+
+	bundle = NewBundle(WithDefaultLocale("en"), WithLocales("en", "ar"))
+	assert.NoError(bundle.LoadMessages(messages))
+	assert.Equal("مرحبا Alice", bundle.NewLocalizer("ar").Get("welcome", Vars{"name": "Alice"}))
+}
+
+func TestDirection(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.True(IsRTL("ar"))
+	assert.True(IsRTL("he-IL"))
+	assert.True(IsRTL("fa"))
+	assert.True(IsRTL("ur"))
+	assert.True(IsRTL("az-Arab"))
+	assert.False(IsRTL("az"))
+	assert.False(IsRTL("en"))
+	assert.False(IsRTL("zh-Hans"))
+	assert.False(IsRTL("not a locale"))
+
+	bundle := newTestParseBundle()
+	assert.Equal(RTL, bundle.NewLocalizer("ar").Direction())
+	assert.Equal(LTR, bundle.NewLocalizer("de-CH").Direction())
+}
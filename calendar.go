@@ -0,0 +1,230 @@
+package i18n
+
+import (
+	"strings"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+// Calendar is a CLDR calendar used to format the dates, the `ca` key of a locale like `ja-JP-u-ca-japanese`.
+type Calendar string
+
+// The calendars supported by the date formatting, the others are formatted with the Gregorian calendar.
+const (
+	CalendarGregorian Calendar = "gregory"
+	// CalendarJapanese counts the years from the start of the Japanese eras since Meiji (1868),
+	// the earlier dates are Gregorian.
+	CalendarJapanese Calendar = "japanese"
+	// CalendarBuddhist counts the years of the Buddhist era, 543 years before the Common Era.
+	CalendarBuddhist Calendar = "buddhist"
+	// CalendarIslamic is the tabular civil Islamic calendar, also used for the `islamic-*` variants of CLDR.
+	CalendarIslamic Calendar = "islamic"
+)
+
+// WithCalendar returns a copy of the localizer that formats the dates with a calendar, like the `ca` key of
+// a locale passed to `NewLocalizer`, e.g. `ja-JP-u-ca-japanese`.
+func (localizer *Localizer) WithCalendar(calendar Calendar) *Localizer {
+	l := *localizer
+	l.calendar = normalizeCalendar(string(calendar))
+	return &l
+}
+
+// Calendar returns the calendar of the dates formatted by the localizer, `CalendarGregorian` by default.
+func (localizer *Localizer) Calendar() Calendar {
+	return calendarOf(localizer.tag())
+}
+
+// tag returns the language tag of the locale of the localizer with its calendar, if any.
+func (localizer *Localizer) tag() language.Tag {
+	tag := language.Make(localizer.locale)
+	if localizer.calendar != "" {
+		if t, err := tag.SetTypeForKey("ca", string(localizer.calendar)); err == nil {
+			tag = t
+		}
+	}
+	return tag
+}
+
+// requestedCalendar returns the calendar of the first locale with a `ca` key, empty if none.
+func requestedCalendar(locales []string) Calendar {
+	for _, locale := range locales {
+		if !strings.Contains(locale, "-u-") && !strings.Contains(locale, "_u_") {
+			continue
+		}
+		if ca := language.Make(locale).TypeForKey("ca"); ca != "" {
+			return normalizeCalendar(ca)
+		}
+	}
+	return ""
+}
+
+// normalizeCalendar returns the supported calendar of a CLDR calendar name, `CalendarGregorian` if it's not supported.
+func normalizeCalendar(name string) Calendar {
+	switch {
+	case name == string(CalendarJapanese):
+		return CalendarJapanese
+	case name == string(CalendarBuddhist):
+		return CalendarBuddhist
+	case name == string(CalendarIslamic) || strings.HasPrefix(name, string(CalendarIslamic)+"-"):
+		return CalendarIslamic
+	}
+	return CalendarGregorian
+}
+
+// calendarOf returns the calendar of the `ca` key of a tag, `CalendarGregorian` if none.
+func calendarOf(tag language.Tag) Calendar {
+	return normalizeCalendar(tag.TypeForKey("ca"))
+}
+
+// calendarTime is a time of the vars of a message formatted with the calendar of the localizer.
+// It embeds the time so that it's printed as is by the arguments without a date type.
+type calendarTime struct {
+	time.Time
+	calendar Calendar
+}
+
+// calendarDate is a date in a calendar, the era is the index of the era names of the calendar.
+type calendarDate struct {
+	calendar   Calendar
+	era        int
+	year       int
+	month, day int
+}
+
+// japaneseEras are the start dates of the Japanese eras since Meiji.
+var japaneseEras = []time.Time{
+	time.Date(1868, time.September, 8, 0, 0, 0, 0, time.UTC),
+	time.Date(1912, time.July, 30, 0, 0, 0, 0, time.UTC),
+	time.Date(1926, time.December, 25, 0, 0, 0, 0, time.UTC),
+	time.Date(1989, time.January, 8, 0, 0, 0, 0, time.UTC),
+	time.Date(2019, time.May, 1, 0, 0, 0, 0, time.UTC),
+}
+
+// japaneseNarrowEras are the narrow names of the Japanese eras, e.g. `R` in `R7/3/7`.
+var japaneseNarrowEras = []string{"M", "T", "S", "H", "R"}
+
+// islamicMonths are the names of the Islamic months, which CLDR only transliterates.
+var islamicMonths = [12]string{"Muharram", "Safar", "Rabiʻ I", "Rabiʻ II", "Jumada I", "Jumada II", "Rajab", "Shaʻban", "Ramadan", "Shawwal", "Dhuʻl-Qiʻdah", "Dhuʻl-Hijjah"}
+
+// islamicAbbrMonths are the abbreviated names of the Islamic months.
+var islamicAbbrMonths = [12]string{"Muh.", "Saf.", "Rab. I", "Rab. II", "Jum. I", "Jum. II", "Raj.", "Sha.", "Ram.", "Shaw.", "Dhuʻl-Q.", "Dhuʻl-H."}
+
+// toCalendarDate converts the date of a time in its location to a calendar.
+func toCalendarDate(calendar Calendar, t time.Time) calendarDate {
+	year, month, day := t.Date()
+	switch calendar {
+	case CalendarJapanese:
+		date := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+		for era := len(japaneseEras) - 1; era >= 0; era-- {
+			if start := japaneseEras[era]; !date.Before(start) {
+				return calendarDate{calendar: calendar, era: era, year: year - start.Year() + 1, month: int(month), day: day}
+			}
+		}
+	case CalendarBuddhist:
+		return calendarDate{calendar: calendar, year: year + 543, month: int(month), day: day}
+	case CalendarIslamic:
+		return islamicDate(year, month, day)
+	}
+	if year <= 0 {
+		return calendarDate{calendar: CalendarGregorian, year: 1 - year, month: int(month), day: day}
+	}
+	return calendarDate{calendar: CalendarGregorian, era: 1, year: year, month: int(month), day: day}
+}
+
+// islamicEpoch is the Julian day number of the first day of the civil Islamic calendar, July 16, 622 (Julian).
+const islamicEpoch = 1948440
+
+// islamicDate converts a Gregorian date to the tabular civil Islamic calendar, the dates before its epoch
+// are Gregorian.
+func islamicDate(year int, month time.Month, day int) calendarDate {
+	// 2440588 is the Julian day number of the Unix epoch.
+	jd := int(floorDiv(time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Unix(), 86400)) + 2440588
+	if jd < islamicEpoch {
+		return toCalendarDate(CalendarGregorian, time.Date(year, month, day, 0, 0, 0, 0, time.UTC))
+	}
+	y := int(floorDiv(int64(30*(jd-islamicEpoch)+10646), 10631))
+	m := int(floorDiv(int64(2*(jd-29-islamicDay(y, 1, 1))+58), 59)) + 1
+	m = max(1, min(m, 12))
+	return calendarDate{calendar: CalendarIslamic, year: y, month: m, day: jd - islamicDay(y, m, 1) + 1}
+}
+
+// islamicDay returns the Julian day number of a date of the civil Islamic calendar.
+func islamicDay(year, month, day int) int {
+	return day + (59*(month-1)+1)/2 + (year-1)*354 + int(floorDiv(int64(3+11*year), 30)) + islamicEpoch - 1
+}
+
+// floorDiv divides rounding toward negative infinity.
+func floorDiv(a, b int64) int64 {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+	return q
+}
+
+// eraName returns the name of the era of a date, the width is the number of `G` of the pattern. The names
+// are abbreviated in all the widths but the narrow Japanese eras, the ones of `en` if the locale has none.
+func eraName(data *localeData, date calendarDate, width int) string {
+	if width == 5 && date.calendar == CalendarJapanese {
+		return japaneseNarrowEras[date.era]
+	}
+	names := data.eras[date.calendar]
+	if len(names) == 0 {
+		names = localeDataTable["en"].eras[date.calendar]
+	}
+	return names[date.era]
+}
+
+// japaneseDateFormats are the full, long, medium and short date patterns of `ja` in the Japanese calendar.
+var japaneseDateFormats = [4]string{"Gy年M月d日EEEE", "Gy年M月d日", "Gy年M月d日", "GGGGGy/M/d"}
+
+// calendarPattern adds the era to the CLDR date pattern of a style for the calendars other than Gregorian:
+// before the year at the start or followed by `年` or `년`, after the other years, e.g. `MMMM d, y G`.
+// The years have all their digits since they restart with every era.
+func calendarPattern(pattern string, calendar Calendar) string {
+	if calendar == CalendarGregorian || strings.Contains(pattern, "G") {
+		return pattern
+	}
+	start := strings.IndexByte(pattern, 'y')
+	if start < 0 {
+		return pattern
+	}
+	end := start
+	for end < len(pattern) && pattern[end] == 'y' {
+		end++
+	}
+	rest := pattern[end:]
+	switch {
+	case strings.HasPrefix(rest, "年"):
+		return pattern[:start] + "Gy" + rest
+	case start == 0 || strings.HasPrefix(rest, "년"):
+		return pattern[:start] + "G y" + rest
+	}
+	return pattern[:start] + "y G" + rest
+}
+
+// dateStylePattern returns the date pattern of a style in the calendar of the tag, see `datePattern`.
+func dateStylePattern(tag language.Tag, style string) string {
+	calendar := calendarOf(tag)
+	if base, _ := tag.Base(); base.String() == "ja" && calendar == CalendarJapanese {
+		return datePattern(japaneseDateFormats, style)
+	}
+	pattern := datePattern(lookupLocaleData(tag).dateFormats, style)
+	if pattern == style {
+		// The patterns of the callers are used as is, they add the era with `G`.
+		return pattern
+	}
+	return calendarPattern(pattern, calendar)
+}
+
+// dateArgumentTag returns the tag of a date argument of a message, with the calendar of the localizer
+// if the value is a `calendarTime`.
+func dateArgumentTag(tag language.Tag, value any) language.Tag {
+	if v, ok := value.(calendarTime); ok {
+		if t, err := tag.SetTypeForKey("ca", string(v.calendar)); err == nil {
+			return t
+		}
+	}
+	return tag
+}
@@ -0,0 +1,71 @@
+package i18n
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+)
+
+func TestFormatDateCalendars(t *testing.T) {
+	assert := assert.New(t)
+
+	bundle := newTestParseBundle()
+	when := time.Date(2025, time.March, 7, 14, 5, 9, 0, time.UTC)
+
+	ja := bundle.NewLocalizer("ja-JP-u-ca-japanese")
+	assert.Equal("ja", ja.Locale())
+	assert.Equal(CalendarJapanese, ja.Calendar())
+	assert.Equal("令和7年3月7日金曜日", ja.FormatDate(when, DateFull))
+	assert.Equal("令和7年3月7日", ja.FormatDate(when, DateMedium))
+	assert.Equal("R7/3/7", ja.FormatDate(when, DateShort))
+	assert.Equal("令和元年5月1日", ja.FormatDate(time.Date(2019, time.May, 1, 0, 0, 0, 0, time.UTC), DateLong))
+	assert.Equal("H31/4/30", ja.FormatDate(time.Date(2019, time.April, 30, 0, 0, 0, 0, time.UTC), DateShort))
+	assert.Equal("昭和64年1月7日", ja.FormatDate(time.Date(1989, time.January, 7, 0, 0, 0, 0, time.UTC), DateLong))
+
+	en := bundle.NewLocalizer("en")
+	assert.Equal(CalendarGregorian, en.Calendar())
+	assert.Equal("March 7, 7 Reiwa", en.WithCalendar(CalendarJapanese).FormatDate(when, DateLong))
+	assert.Equal("March 7, 2568 BE", en.WithCalendar(CalendarBuddhist).FormatDate(when, DateLong))
+	assert.Equal("Ramadan 7, 1446 AH", en.WithCalendar(CalendarIslamic).FormatDate(when, DateLong))
+	assert.Equal("Ramadan 7, 1446 AH", bundle.NewLocalizer("en-u-ca-islamic-civil").FormatDate(when, DateLong))
+	assert.Equal("March 7, 2025", en.FormatDate(when, DateLong))
+
+	assert.Equal("仏暦2568年3月7日金曜日", bundle.NewLocalizer("ja-u-ca-buddhist").FormatDate(when, DateFull))
+	assert.Equal("불기 2568년 3월 7일", bundle.NewLocalizer("ko").WithCalendar(CalendarBuddhist).FormatDate(when, DateLong))
+	// The patterns of the callers are used as is.
+	assert.Equal("令和 7/3/7", ja.FormatDate(when, "G y/M/d"))
+	assert.Equal("Reiwa 7", en.WithCalendar(CalendarJapanese).FormatDate(when, "G y"))
+}
+
+func TestToCalendarDate(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(calendarDate{calendar: CalendarIslamic, year: 1, month: 1, day: 1},
+		toCalendarDate(CalendarIslamic, time.Date(622, time.July, 19, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(calendarDate{calendar: CalendarIslamic, year: 1445, month: 12, day: 30},
+		toCalendarDate(CalendarIslamic, time.Date(2024, time.July, 7, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(calendarDate{calendar: CalendarIslamic, year: 1446, month: 1, day: 1},
+		toCalendarDate(CalendarIslamic, time.Date(2024, time.July, 8, 0, 0, 0, 0, time.UTC)))
+	// The dates before the first era are Gregorian.
+	assert.Equal(calendarDate{calendar: CalendarGregorian, era: 1, year: 1868, month: 9, day: 7},
+		toCalendarDate(CalendarJapanese, time.Date(1868, time.September, 7, 0, 0, 0, 0, time.UTC)))
+	assert.Equal("BC 44", formatDatePattern(language.English, time.Date(-43, time.March, 15, 0, 0, 0, 0, time.UTC), "G y"))
+}
+
+func TestCalendarDateArguments(t *testing.T) {
+	assert := assert.New(t)
+
+	bundle := newTestParseBundle()
+	assert.NoError(bundle.LoadMessages(map[string]map[string]string{
+		"en": {"due": "Due {when, date, long}"},
+		"ja": {"due": "期限：{when, date, long}"},
+	}))
+	vars := Vars{"when": time.Date(2025, time.March, 7, 14, 5, 0, 0, time.UTC)}
+
+	assert.Equal("期限：令和7年3月7日", bundle.NewLocalizer("ja-JP-u-ca-japanese").Get("due", vars))
+	assert.Equal("期限：令和7年3月7日", bundle.LocalizerFor("ja-JP-u-ca-japanese").Get("due", vars))
+	assert.Equal("期限：2025年3月7日", bundle.LocalizerFor("ja-JP").Get("due", vars))
+	assert.Equal("Due Ramadan 7, 1446 AH", bundle.NewLocalizer("en").WithCalendar(CalendarIslamic).Get("due", vars))
+}
@@ -0,0 +1,44 @@
+package i18n
+
+import "context"
+
+// CatalogClient is a client of a central translation service, like the `CatalogService` of
+// `proto/i18n/v1/catalog.proto`. An adapter of the generated gRPC client keeps the package free of
+// the gRPC dependencies.
+type CatalogClient interface {
+	// GetCatalog returns the messages of the locales, all the locales if none is given, limited to the names
+	// that start with one of the prefixes if any.
+	GetCatalog(ctx context.Context, locales, prefixes []string) (map[string]map[string]string, error)
+}
+
+// CatalogLoader is a `Loader` that pulls the catalogs of a service from a central translation service.
+type CatalogLoader struct {
+	Client CatalogClient
+	// Locales are the locales to pull, all the locales of the service if empty.
+	Locales []string
+	// Prefixes limit the messages to the ones used by the service, like `checkout.`.
+	Prefixes []string
+}
+
+// Load pulls the catalogs.
+func (loader *CatalogLoader) Load(ctx context.Context) (map[string]map[string]string, error) {
+	return loader.Client.GetCatalog(ctx, loader.Locales, loader.Prefixes)
+}
+
+// LoadLocale pulls the catalog of a locale on demand, like a locale requested for the first time,
+// and adds it to the bundle. The locale is registered if the bundle doesn't support it yet.
+func (loader *CatalogLoader) LoadLocale(ctx context.Context, bundle *I18n, locale string) error {
+	catalogs, err := loader.Client.GetCatalog(ctx, []string{locale}, loader.Prefixes)
+	if err != nil {
+		return err
+	}
+	if err := bundle.RegisterLocale(locale); err != nil {
+		return err
+	}
+	for _, messages := range catalogs {
+		if err := bundle.AddMessages(locale, messages); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package i18n
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testCatalogClient is an in-memory `CatalogClient`.
+type testCatalogClient map[string]map[string]string
+
+func (c testCatalogClient) GetCatalog(_ context.Context, locales, prefixes []string) (map[string]map[string]string, error) {
+	if len(locales) == 0 {
+		for locale := range c {
+			locales = append(locales, locale)
+		}
+	}
+	catalogs := make(map[string]map[string]string)
+	for _, locale := range locales {
+		catalogs[locale] = make(map[string]string)
+		for name, text := range c[locale] {
+			for _, prefix := range prefixes {
+				if strings.HasPrefix(name, prefix) {
+					catalogs[locale][name] = text
+				}
+			}
+		}
+	}
+	return catalogs, nil
+}
+
+func TestCatalogLoader(t *testing.T) {
+	assert := assert.New(t)
+
+	loader := &CatalogLoader{
+		Client: testCatalogClient{
+			"en": {"checkout.pay": "Pay", "admin.users": "Users"},
+			"fr": {"checkout.pay": "Payer"},
+		},
+		Prefixes: []string{"checkout."},
+	}
+	bundle := NewBundle(WithDefaultLocale("en"))
+	assert.NoError(bundle.LoadFrom(context.Background(), loader))
+	localizer := bundle.NewLocalizer("en")
+	assert.Equal("Pay", localizer.Get("checkout.pay"))
+	assert.Equal("admin.users", localizer.Get("admin.users"))
+
+	assert.Equal("Pay", bundle.NewLocalizer("fr").Get("checkout.pay"))
+	assert.NoError(loader.LoadLocale(context.Background(), bundle, "fr"))
+	assert.Equal("Payer", bundle.NewLocalizer("fr").Get("checkout.pay"))
+}
@@ -0,0 +1,58 @@
+package i18n
+
+import (
+	"context"
+	"io/fs"
+
+	"golang.org/x/text/language"
+)
+
+// ChainLoader is a `Loader` that merges the translations of its loaders in priority order, the later loaders
+// override the names of the earlier ones. Layer the sources like embedded defaults < disk < remote,
+// then the environment-specific overrides.
+type ChainLoader []Loader
+
+// Load merges the translations of the loaders, it fails on the first error. The locales are canonicalized.
+func (chain ChainLoader) Load(ctx context.Context) (map[string]map[string]string, error) {
+	data := make(map[string]map[string]string)
+	for _, loader := range chain {
+		languages, err := loader.Load(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for locale, trans := range languages {
+			// The files name the locales like `zh-hans`, the code like `zh-Hans`.
+			if tag, err := language.Parse(locale); err == nil {
+				locale = tag.String()
+			}
+			if _, ok := data[locale]; !ok {
+				data[locale] = make(map[string]string)
+			}
+			for name, text := range trans {
+				data[locale][name] = text
+			}
+		}
+	}
+	return data, nil
+}
+
+// FSLoader is a `Loader` that reads the files of a `fs.FS` like `LoadFS` does, e.g. an `embed.FS` or `os.DirFS`.
+type FSLoader struct {
+	FS       fs.FS
+	Patterns []string
+	// Unmarshaler unmarshals the files, detected from each file if nil.
+	Unmarshaler Unmarshaler
+}
+
+// Load reads the files.
+func (loader *FSLoader) Load(context.Context) (map[string]map[string]string, error) {
+	return readFS(loader.FS, loader.Patterns, loader.Unmarshaler)
+}
+
+// MapLoader is a `Loader` of the translations written in code, like the overrides of an environment.
+type MapLoader map[string]map[string]string
+
+// Load returns the translations.
+func (loader MapLoader) Load(context.Context) (map[string]map[string]string, error) {
+	return loader, nil
+}
@@ -0,0 +1,54 @@
+package i18n
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testFailingLoader is a `Loader` that fails.
+type testFailingLoader struct{}
+
+func (testFailingLoader) Load(context.Context) (map[string]map[string]string, error) {
+	return nil, errors.New("unavailable")
+}
+
+func TestChainLoader(t *testing.T) {
+	assert := assert.New(t)
+
+	embedded := &FSLoader{
+		FS: fstest.MapFS{
+			"locales/en.json":      {Data: []byte(`{"hello": "Hello", "bye": "Bye", "title": "App"}`)},
+			"locales/zh-Hans.yaml": {Data: []byte("hello: 你好\n")},
+		},
+		Patterns: []string{"locales/*"},
+	}
+	disk := &FSLoader{
+		FS:       fstest.MapFS{"en.json": {Data: []byte(`{"bye": "Goodbye"}`)}},
+		Patterns: []string{"*.json"},
+	}
+	overrides := MapLoader{"en": {"title": "App (staging)"}, "zh_Hans": {"bye": "再见"}}
+
+	chain := ChainLoader{embedded, disk, overrides}
+	data, err := chain.Load(context.Background())
+	assert.NoError(err)
+	assert.Equal(map[string]map[string]string{
+		"en":      {"hello": "Hello", "bye": "Goodbye", "title": "App (staging)"},
+		"zh-Hans": {"hello": "你好", "bye": "再见"},
+	}, data)
+
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "zh-Hans"),
+	)
+	assert.NoError(bundle.LoadFrom(context.Background(), chain))
+	localizer := bundle.NewLocalizer("zh-Hans")
+	assert.Equal("你好", localizer.Get("hello"))
+	assert.Equal("App (staging)", localizer.Get("title"))
+
+	_, err = ChainLoader{embedded, testFailingLoader{}}.Load(context.Background())
+	assert.Error(err)
+}
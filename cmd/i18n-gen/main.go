@@ -0,0 +1,44 @@
+// Command i18n-gen compiles the translation files to Go source, see `i18n.GenerateGo`.
+//
+//	//go:generate go run github.com/kaptinlin/go-i18n/cmd/i18n-gen -pkg locales -o messages_gen.go *.json
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/kaptinlin/go-i18n"
+)
+
+func main() {
+	pkg := flag.String("pkg", "", "the package name of the generated file, $GOPACKAGE by default")
+	out := flag.String("o", "messages_gen.go", "the generated file")
+	flag.Parse()
+
+	if err := run(*pkg, *out, flag.Args()); err != nil {
+		fmt.Fprintln(os.Stderr, "i18n-gen:", err)
+		os.Exit(1)
+	}
+}
+
+// run writes the Go source generated from the files matching the patterns to out.
+func run(pkg, out string, patterns []string) error {
+	if len(patterns) == 0 {
+		return fmt.Errorf("no translation file pattern")
+	}
+	if pkg == "" {
+		// go generate sets the package of the file with the directive.
+		pkg = os.Getenv("GOPACKAGE")
+	}
+	if pkg == "" {
+		return fmt.Errorf("no package name, set -pkg")
+	}
+
+	var buf bytes.Buffer
+	if err := i18n.GenerateGo(&buf, pkg, os.DirFS("."), patterns...); err != nil {
+		return err
+	}
+	return os.WriteFile(out, buf.Bytes(), 0o644) //nolint:gosec
+}
@@ -0,0 +1,39 @@
+package i18n
+
+import (
+	"sync"
+
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+)
+
+// collatorPools are the pools of collators by locale, the collators are not safe for concurrent use.
+var collatorPools sync.Map
+
+// collator returns a collator of the locale from its pool, which must be put back after use.
+func collator(tag language.Tag) (*collate.Collator, *sync.Pool) {
+	pool, ok := collatorPools.Load(tag)
+	if !ok {
+		pool, _ = collatorPools.LoadOrStore(tag, &sync.Pool{
+			New: func() any { return collate.New(tag) },
+		})
+	}
+	p := pool.(*sync.Pool)
+	return p.Get().(*collate.Collator), p
+}
+
+// Compare compares two strings with the collation rules of the locale, e.g. `ä` sorts with `a` in `de`
+// and after `z` in `sv`. The result is -1 if a < b, 0 if a == b and +1 if a > b.
+func (localizer *Localizer) Compare(a, b string) int {
+	c, pool := collator(localizer.tag())
+	defer pool.Put(c)
+	return c.CompareString(a, b)
+}
+
+// SortStrings sorts strings in place with the collation rules of the locale,
+// instead of the order of their Unicode code points.
+func (localizer *Localizer) SortStrings(s []string) {
+	c, pool := collator(localizer.tag())
+	defer pool.Put(c)
+	c.SortStrings(s)
+}
@@ -0,0 +1,30 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompare(t *testing.T) {
+	assert := assert.New(t)
+	bundle := NewBundle(WithDefaultLocale("en"), WithLocales("en", "de", "sv"))
+	assert.NoError(bundle.LoadMessages(map[string]map[string]string{
+		"en": {"hello": "hello"},
+		"de": {"hello": "hallo"},
+		"sv": {"hello": "hej"},
+	}))
+
+	assert.Equal(-1, bundle.NewLocalizer("de").Compare("äpfel", "zebra"))
+	assert.Equal(1, bundle.NewLocalizer("sv").Compare("äpfel", "zebra"))
+	assert.Equal(-1, bundle.NewLocalizer("en").Compare("apple", "Banana"))
+	assert.Equal(0, bundle.NewLocalizer("en").Compare("same", "same"))
+
+	names := []string{"Zoë", "Émile", "adam", "Bob"}
+	bundle.NewLocalizer("en").SortStrings(names)
+	assert.Equal([]string{"adam", "Bob", "Émile", "Zoë"}, names)
+
+	names = []string{"Örjan", "Anna", "Zlatan"}
+	bundle.NewLocalizer("sv").SortStrings(names)
+	assert.Equal([]string{"Anna", "Zlatan", "Örjan"}, names)
+}
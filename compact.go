@@ -0,0 +1,64 @@
+package i18n
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/number"
+)
+
+// maxCompactExponent is the largest power of ten with a compact pattern, larger numbers use its pattern.
+const maxCompactExponent = 14
+
+// FormatCompact formats a number with the short compact pattern of the locale, like the counters of dashboards,
+// e.g. `1.2K` in `en`, `1,2 Mio.` in `de` for 1234567 and `1.2万` in `zh` for 12345.
+// The number is rounded to 2 significant digits if its integer part has a single digit, to an integer otherwise.
+func (localizer *Localizer) FormatCompact(v any) string {
+	n, ok := float64Value(v)
+	if !ok {
+		return fmt.Sprint(v)
+	}
+	return formatCompact(localizer.tag(), n)
+}
+
+// formatCompact formats a number with the short compact patterns of the locale, see `localeData.compactDecimals`.
+func formatCompact(tag language.Tag, n float64) string {
+	abs := math.Abs(n)
+	if abs < 1 || math.IsInf(abs, 0) || math.IsNaN(abs) {
+		return formatNumber(tag, n, &NumberOptions{MaxFractionDigits: 1}, number.Decimal)
+	}
+	patterns := lookupLocaleData(tag).compactDecimals
+	exponent := int(math.Floor(math.Log10(abs)))
+	for {
+		pattern, divisor := "0", 1.0
+		if exponent >= 3 {
+			pattern = patterns[min(exponent, maxCompactExponent)-3]
+			if pattern != "0" {
+				divisor = math.Pow10(min(exponent, maxCompactExponent) - strings.Count(pattern, "0") + 1)
+			}
+		}
+		fraction := 0
+		if abs/divisor < 10 {
+			fraction = 1
+		}
+		scale := math.Pow10(fraction)
+		rounded := math.Round(abs/divisor*scale) / scale
+		// 999,950 is rounded to 1000K, which is formatted with the pattern of 10^6 instead.
+		if exponent < maxCompactExponent && rounded*divisor >= math.Pow10(exponent+1) {
+			exponent++
+			continue
+		}
+		s := formatNumber(tag, math.Copysign(rounded, n), &NumberOptions{MaxFractionDigits: fraction}, number.Decimal)
+		if pattern == "0" {
+			return s
+		}
+		return pattern[:strings.Index(pattern, "0")] + s + pattern[strings.LastIndex(pattern, "0")+1:]
+	}
+}
+
+// isCompactStyle reports whether the style of a `{n, number}` argument is an ICU skeleton of the short compact notation.
+func isCompactStyle(style string) bool {
+	return style == "::compact-short" || style == "::K"
+}
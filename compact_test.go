@@ -0,0 +1,88 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatCompact(t *testing.T) {
+	assert := assert.New(t)
+	bundle := newTestParseBundle()
+
+	cases := []struct {
+		locale string
+		value  any
+		want   string
+	}{
+		{"en", 0, "0"},
+		{"en", 999, "999"},
+		{"en", 1234, "1.2K"},
+		{"en", 12345, "12K"},
+		{"en", 123456, "123K"},
+		{"en", 999999, "1M"},
+		{"en", 3400000, "3.4M"},
+		{"en", int64(-1500), "-1.5K"},
+		{"en", 2.5e9, "2.5B"},
+		{"en", 1.2e16, "12,000T"},
+		{"de", 1234, "1.234"},
+		{"de", 1234567, "1,2\u00a0Mio."},
+		{"fr", 12345, "12\u00a0k"},
+		{"ja", 12345, "1.2万"},
+		{"zh-Hans", 123456789, "1.2亿"},
+		{"ko", 1234, "1.2천"},
+		{"ru", 5000, "5\u00a0тыс."},
+		{"en", "many", "many"},
+	}
+	for _, c := range cases {
+		assert.Equal(c.want, bundle.NewLocalizer(c.locale).FormatCompact(c.value), "%s %v", c.locale, c.value)
+	}
+}
+
+func TestCompactArguments(t *testing.T) {
+	assert := assert.New(t)
+
+	bundle := NewBundle(WithDefaultLocale("en"), WithLocales("en", "zh-Hans"))
+	assert.NoError(bundle.LoadMessages(map[string]map[string]string{
+		"en":      {"followers": "{n, number, ::compact-short} followers", "views": "{n, number, ::K} views"},
+		"zh-Hans": {"followers": "{n, number, ::compact-short} 关注者"},
+	}))
+
+	assert.Equal("12K followers", bundle.NewLocalizer("en").Get("followers", Vars{"n": 12345}))
+	assert.Equal("3.4M views", bundle.NewLocalizer("en").Get("views", Vars{"n": 3400000}))
+	assert.Equal("1.2万 关注者", bundle.NewLocalizer("zh-Hans").Get("followers", Vars{"n": 12345}))
+}
+
+func TestCompactArgumentTypes(t *testing.T) {
+	assert := assert.New(t)
+
+	bundle := NewBundle(WithDefaultLocale("en"), WithLocales("en"))
+	assert.NoError(bundle.LoadMessages(map[string]map[string]string{
+		"en": {"followers": "{n, number, ::compact-short} followers"},
+	}))
+	localizer := bundle.NewLocalizer("en")
+
+	cases := []struct {
+		value any
+		want  string
+	}{
+		{int(12345), "12K followers"},
+		{int8(12), "12 followers"},
+		{int16(12345), "12K followers"},
+		{int32(12345), "12K followers"},
+		{int64(3400000000), "3.4B followers"},
+		{uint(12345), "12K followers"},
+		{uint8(12), "12 followers"},
+		{uint16(12345), "12K followers"},
+		{uint32(12345), "12K followers"},
+		{uint64(3400000000), "3.4B followers"},
+		{float32(1234.5), "1.2K followers"},
+		{float64(1234.5), "1.2K followers"},
+		{"1234.5", "1.2K followers"},
+	}
+	for _, c := range cases {
+		s, err := localizer.GetE("followers", Vars{"n": c.value})
+		assert.NoError(err, "%T", c.value)
+		assert.Equal(c.want, s, "%T", c.value)
+	}
+}
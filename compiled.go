@@ -0,0 +1,81 @@
+package i18n
+
+import (
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// ErrInvalidCompiled is returned when a file isn't a catalog saved by `SaveCompiled`.
+var ErrInvalidCompiled = errors.New("i18n: invalid compiled catalog")
+
+// compiledMagic identifies the catalogs saved by `SaveCompiled`, with the version of their format.
+const compiledMagic = "go-i18n/compiled/v1"
+
+// compiledCatalog is the content of a catalog saved by `SaveCompiled`, the messages are the texts checked by compiling them.
+type compiledCatalog struct {
+	Magic string
+	// Locales are the messages of each locale, without the ones resolved from the fallbacks.
+	Locales map[string]map[string]string
+}
+
+// SaveCompiled saves the loaded catalogs to a binary file that `LoadCompiled` loads at the next start, e.g. built
+// at deploy time for the cold starts of serverless functions. Every message is compiled before the file is written,
+// also with `WithLazyCompile`, so a broken message fails the save and never reaches the file.
+// The compiled forms themselves can't be serialized: the file holds the checked message texts.
+func (bundle *I18n) SaveCompiled(path string) error {
+	bundle.mu.RLock()
+	c := compiledCatalog{
+		Magic:   compiledMagic,
+		Locales: make(map[string]map[string]string, len(bundle.parsedTranslations)),
+	}
+	var saved []*parsedTranslation
+	for locale, translations := range bundle.parsedTranslations {
+		messages := make(map[string]string, len(translations))
+		for name, trans := range translations {
+			if trans.locale == locale {
+				messages[name] = trans.text
+				saved = append(saved, trans)
+			}
+		}
+		c.Locales[locale] = messages
+	}
+	bundle.mu.RUnlock()
+
+	for _, trans := range saved {
+		if _, err := trans.compile(); err != nil {
+			return fmt.Errorf("%s %q: %w", trans.locale, trans.name, err)
+		}
+	}
+
+	f, err := os.Create(path) //nolint:gosec
+	if err != nil {
+		return err
+	}
+	if err := gob.NewEncoder(f).Encode(c); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// LoadCompiled loads the catalogs saved by `SaveCompiled` like `LoadMessages` does, without decoding the translation
+// files. The messages are compiled upfront, or on their first use with `WithLazyCompile`: since they were checked
+// when they were saved, the lazy bundles skip all the compilation work at startup without the risk of a broken message.
+func (bundle *I18n) LoadCompiled(path string) error {
+	f, err := os.Open(path) //nolint:gosec
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var c compiledCatalog
+	if err := gob.NewDecoder(f).Decode(&c); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidCompiled, err)
+	}
+	if c.Magic != compiledMagic {
+		return fmt.Errorf("%w: %q", ErrInvalidCompiled, path)
+	}
+	return bundle.LoadMessages(c.Locales)
+}
@@ -0,0 +1,71 @@
+package i18n
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSaveCompiled(t *testing.T) {
+	assert := assert.New(t)
+
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "zh-Hans"),
+	)
+	assert.NoError(bundle.LoadMessages(map[string]map[string]string{
+		"en":      {"hello": "Hello, {name}", "bye": "Bye"},
+		"zh-Hans": {"hello": "你好, {name}"},
+	}))
+	path := filepath.Join(t.TempDir(), "catalog.bin")
+	assert.NoError(bundle.SaveCompiled(path))
+
+	loaded := NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "zh-Hans"),
+	)
+	assert.NoError(loaded.LoadCompiled(path))
+	assert.NotNil(loaded.parsedTranslations["en"]["hello"].format)
+	// The fallbacks are resolved again rather than saved.
+	assert.Equal("en", loaded.parsedTranslations["zh-Hans"]["bye"].locale)
+
+	localizer := loaded.NewLocalizer("zh-Hans")
+	assert.Equal("你好, Yami", localizer.Get("hello", Vars{"name": "Yami"}))
+	assert.Equal("Bye", localizer.Get("bye"))
+
+	// The lazy bundles compile the messages on their first use.
+	lazy := NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "zh-Hans"),
+		WithLazyCompile(true),
+	)
+	assert.NoError(lazy.LoadCompiled(path))
+	assert.Nil(lazy.parsedTranslations["en"]["hello"].format)
+	assert.Equal("Hello, Yami", lazy.NewLocalizer("en").Get("hello", Vars{"name": "Yami"}))
+
+	invalid := filepath.Join(t.TempDir(), "invalid.bin")
+	assert.NoError(os.WriteFile(invalid, []byte("not a catalog"), 0o600))
+	assert.ErrorIs(loaded.LoadCompiled(invalid), ErrInvalidCompiled)
+	assert.Error(loaded.LoadCompiled(filepath.Join(t.TempDir(), "missing.bin")))
+}
+
+func TestSaveCompiledBrokenMessage(t *testing.T) {
+	assert := assert.New(t)
+
+	// The lazy bundles load the broken messages, they're compiled before they're saved.
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en"),
+		WithLazyCompile(true),
+	)
+	assert.NoError(bundle.LoadMessages(map[string]map[string]string{
+		"en": {"broken": "Hello, {name"},
+	}))
+	path := filepath.Join(t.TempDir(), "catalog.bin")
+	err := bundle.SaveCompiled(path)
+	assert.ErrorContains(err, `en "broken"`)
+	_, statErr := os.Stat(path)
+	assert.True(os.IsNotExist(statErr))
+}
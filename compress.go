@@ -0,0 +1,55 @@
+package i18n
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+)
+
+// zstdDecoder is shared by the loads, it's safe for concurrent use with `DecodeAll`.
+var zstdDecoder = sync.OnceValues(func() (*zstd.Decoder, error) {
+	return zstd.NewReader(nil, zstd.WithDecoderConcurrency(0))
+})
+
+// decompress decompresses the gzip and zstd files, detected by their magic number, and trims the `.gz`
+// and `.zst` extensions of their names so the format of the content is detected like `en.json.gz` is `en.json`.
+func decompress(file string, data []byte) (string, []byte, error) {
+	switch {
+	case bytes.HasPrefix(data, gzipMagic):
+		r, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return file, nil, err
+		}
+		defer r.Close()
+		data, err = io.ReadAll(r)
+		if err != nil {
+			return file, nil, err
+		}
+	case bytes.HasPrefix(data, zstdMagic):
+		decoder, err := zstdDecoder()
+		if err != nil {
+			return file, nil, err
+		}
+		data, err = decoder.DecodeAll(data, nil)
+		if err != nil {
+			return file, nil, err
+		}
+	default:
+		return file, data, nil
+	}
+	switch strings.ToLower(filepath.Ext(file)) {
+	case ".gz", ".zst":
+		file = strings.TrimSuffix(file, filepath.Ext(file))
+	}
+	return file, data, nil
+}
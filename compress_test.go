@@ -0,0 +1,55 @@
+package i18n
+
+import (
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadCompressedFiles(t *testing.T) {
+	assert := assert.New(t)
+
+	var gz bytes.Buffer
+	w := gzip.NewWriter(&gz)
+	_, err := w.Write([]byte(`{"hello": "Hello", "nav": {"home": "Home"}}`))
+	assert.NoError(err)
+	assert.NoError(w.Close())
+
+	encoder, err := zstd.NewWriter(nil)
+	assert.NoError(err)
+	zst := encoder.EncodeAll([]byte("hello: 你好\n"), nil)
+
+	dir := t.TempDir()
+	assert.NoError(os.WriteFile(filepath.Join(dir, "en.json.gz"), gz.Bytes(), 0o600))
+	assert.NoError(os.WriteFile(filepath.Join(dir, "zh-Hans.yaml.zst"), zst, 0o600))
+
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "zh-Hans"),
+	)
+	assert.NoError(bundle.LoadGlob(filepath.Join(dir, "*")))
+	assert.Equal("Home", bundle.NewLocalizer("en").Get("nav.home"))
+	assert.Equal("你好", bundle.NewLocalizer("zh-Hans").Get("hello"))
+
+	bundle = NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "zh-Hans"),
+	)
+	assert.NoError(bundle.LoadFS(fstest.MapFS{
+		"locales/en.json.gz":       {Data: gz.Bytes()},
+		"locales/zh-Hans.yaml.zst": {Data: zst},
+	}, "locales/*"))
+	assert.Equal("Hello", bundle.NewLocalizer("en").Get("hello"))
+	assert.Equal("你好", bundle.NewLocalizer("zh-Hans").Get("hello"))
+
+	assert.NoError(bundle.LoadBytes("en", zst))
+	assert.Equal("你好", bundle.NewLocalizer("en").Get("hello"))
+
+	assert.Error(bundle.LoadBytes("en", gz.Bytes()[:12]))
+}
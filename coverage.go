@@ -0,0 +1,38 @@
+package i18n
+
+import "sort"
+
+// MissingTranslations returns the names of the messages that exist in the default locale
+// but are not translated in the locale itself, sorted by name. Messages resolved from the fallbacks count as missing.
+func (bundle *I18n) MissingTranslations(locale string) []string {
+	bundle.mu.RLock()
+	defer bundle.mu.RUnlock()
+
+	return bundle.missingTranslations(locale)
+}
+
+// missingTranslations, the caller must hold the lock.
+func (bundle *I18n) missingTranslations(locale string) []string {
+	locale = bundle.getExactSupportedLocale(locale)
+
+	var missing []string
+	for name := range bundle.parsedTranslations[bundle.defaultLocale] {
+		if trans, ok := bundle.parsedTranslations[locale][name]; !ok || trans.locale != locale {
+			missing = append(missing, name)
+		}
+	}
+	sort.Strings(missing)
+	return missing
+}
+
+// Coverage returns the ratio (0 to 1) of the messages of the default locale that are translated in the locale itself.
+func (bundle *I18n) Coverage(locale string) float64 {
+	bundle.mu.RLock()
+	defer bundle.mu.RUnlock()
+
+	total := len(bundle.parsedTranslations[bundle.defaultLocale])
+	if total == 0 {
+		return 1
+	}
+	return float64(total-len(bundle.missingTranslations(locale))) / float64(total)
+}
@@ -0,0 +1,54 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMissingTranslations(t *testing.T) {
+	assert := assert.New(t)
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "zh-Hans", "ja-JP"),
+	)
+	bundle.LoadMessages(map[string]map[string]string{
+		"en": {
+			"hello":   "Hello",
+			"goodbye": "Goodbye",
+			"thanks":  "Thanks",
+		},
+		"zh-Hans": {
+			"hello": "你好",
+		},
+	})
+
+	assert.Equal([]string{"goodbye", "thanks"}, bundle.MissingTranslations("zh-Hans"))
+	assert.Equal([]string{"goodbye", "hello", "thanks"}, bundle.MissingTranslations("ja-JP"))
+	assert.Empty(bundle.MissingTranslations("en"))
+}
+
+func TestCoverage(t *testing.T) {
+	assert := assert.New(t)
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "zh-Hans", "ja-JP"),
+	)
+	assert.Equal(1.0, bundle.Coverage("zh-Hans"))
+
+	bundle.LoadMessages(map[string]map[string]string{
+		"en": {
+			"hello":   "Hello",
+			"goodbye": "Goodbye",
+			"thanks":  "Thanks",
+			"sorry":   "Sorry",
+		},
+		"zh-Hans": {
+			"hello": "你好",
+		},
+	})
+
+	assert.Equal(1.0, bundle.Coverage("en"))
+	assert.Equal(0.25, bundle.Coverage("zh-Hans"))
+	assert.Equal(0.0, bundle.Coverage("ja-JP"))
+}
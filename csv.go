@@ -0,0 +1,188 @@
+package i18n
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// CSVOptions maps the columns of a CSV or TSV file of translations, see `LoadCSV` and `ExportCSV`.
+// The columns are identified by the headers of the first row.
+type CSVOptions struct {
+	// Comma is the field delimiter, `,` by default, `\t` for TSV.
+	Comma rune
+	// KeyColumn is the header of the column of the message names, `key` by default.
+	KeyColumn string
+	// ContextColumn is the header of the column of the `GetX` contexts, none if empty.
+	ContextColumn string
+	// CommentColumn is the header of the column of the notes for the translators, none if empty.
+	// The comments are not loaded, the column is left empty when exporting.
+	CommentColumn string
+	// LocaleColumns maps the headers of the translation columns to their locales. If nil, the columns whose
+	// header is a supported locale are loaded, and the columns are named after the locales when exporting.
+	LocaleColumns map[string]string
+}
+
+// keyColumn
+func (opts CSVOptions) keyColumn() string {
+	if opts.KeyColumn == "" {
+		return "key"
+	}
+	return opts.KeyColumn
+}
+
+// newReader
+func (opts CSVOptions) newReader(r io.Reader) *csv.Reader {
+	reader := csv.NewReader(r)
+	if opts.Comma != 0 {
+		reader.Comma = opts.Comma
+	}
+	reader.FieldsPerRecord = -1
+	return reader
+}
+
+// LoadCSV loads the translations from a CSV or TSV file with one row per message and one column per locale,
+// the format that translators working in spreadsheets are used to. The empty cells are not loaded, so the
+// fallbacks apply.
+func (bundle *I18n) LoadCSV(r io.Reader, opts CSVOptions) error {
+	records, err := opts.newReader(r).ReadAll()
+	if err != nil {
+		return err
+	}
+	if len(records) == 0 {
+		return nil
+	}
+
+	header := records[0]
+	keyIndex, contextIndex := -1, -1
+	locales := make(map[int]string)
+	for i, column := range header {
+		column = strings.TrimSpace(strings.TrimPrefix(column, "\ufeff"))
+		switch {
+		case column == opts.keyColumn():
+			keyIndex = i
+		case column == opts.ContextColumn && column != "":
+			contextIndex = i
+		case column == opts.CommentColumn && column != "":
+		case opts.LocaleColumns != nil:
+			if locale, ok := opts.LocaleColumns[column]; ok {
+				locales[i] = locale
+			}
+		default:
+			locales[i] = column
+		}
+	}
+	if keyIndex < 0 {
+		return fmt.Errorf("i18n: missing the %q column", opts.keyColumn())
+	}
+
+	data := make(map[string]map[string]string)
+	for _, record := range records[1:] {
+		if keyIndex >= len(record) || record[keyIndex] == "" {
+			continue
+		}
+		name := record[keyIndex]
+		if contextIndex >= 0 && contextIndex < len(record) && record[contextIndex] != "" {
+			name = withContext(name, record[contextIndex])
+		}
+		for i, locale := range locales {
+			if i >= len(record) || record[i] == "" {
+				continue
+			}
+			if _, ok := data[locale]; !ok {
+				data[locale] = make(map[string]string)
+			}
+			data[locale][name] = record[i]
+		}
+	}
+	return bundle.LoadMessages(data)
+}
+
+// ExportCSV writes the translations as a CSV or TSV file with one row per message and the locales side by side,
+// in the order of `SupportedLanguages`. The cells of the messages that are not translated in a locale are empty.
+func (bundle *I18n) ExportCSV(w io.Writer, opts CSVOptions) error {
+	bundle.mu.RLock()
+	var locales []string
+	for _, tag := range bundle.languages {
+		if _, ok := bundle.parsedTranslations[tag.String()]; ok {
+			locales = append(locales, tag.String())
+		}
+	}
+	texts := make(map[string]map[string]string)
+	for _, locale := range locales {
+		for name, trans := range bundle.parsedTranslations[locale] {
+			if _, ok := texts[name]; !ok {
+				texts[name] = make(map[string]string)
+			}
+			if trans.locale == locale {
+				texts[name][locale] = trans.text
+			}
+		}
+	}
+	bundle.mu.RUnlock()
+
+	// columns maps the locales to their column headers.
+	columns := make(map[string]string, len(locales))
+	for _, locale := range locales {
+		columns[locale] = locale
+	}
+	if opts.LocaleColumns != nil {
+		columns = make(map[string]string)
+		for column, locale := range opts.LocaleColumns {
+			columns[language.Make(locale).String()] = column
+		}
+	}
+
+	header := []string{opts.keyColumn()}
+	if opts.ContextColumn != "" {
+		header = append(header, opts.ContextColumn)
+	}
+	var exported []string
+	for _, locale := range locales {
+		if column, ok := columns[locale]; ok {
+			header = append(header, column)
+			exported = append(exported, locale)
+		}
+	}
+	if opts.CommentColumn != "" {
+		header = append(header, opts.CommentColumn)
+	}
+
+	writer := csv.NewWriter(w)
+	if opts.Comma != 0 {
+		writer.Comma = opts.Comma
+	}
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(texts))
+	for name := range texts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		row := make([]string, 0, len(header))
+		if opts.ContextColumn != "" {
+			key, ctx := splitContext(name)
+			row = append(row, key, ctx)
+		} else {
+			row = append(row, name)
+		}
+		for _, locale := range exported {
+			row = append(row, texts[name][locale])
+		}
+		if opts.CommentColumn != "" {
+			row = append(row, "")
+		}
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
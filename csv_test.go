@@ -0,0 +1,76 @@
+package i18n
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadCSV(t *testing.T) {
+	assert := assert.New(t)
+
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "zh-Hans"),
+	)
+	assert.NoError(bundle.LoadCSV(strings.NewReader(
+		"key,context,en,zh-Hans,note\n"+
+			"hello,,\"Hello, {name}\",\"你好，{name}\",Greeting\n"+
+			"Post,verb,Post,发布,\n"+
+			"bye,,Bye,,\n"+
+			",,Skipped,,\n",
+	), CSVOptions{ContextColumn: "context", CommentColumn: "note"}))
+
+	localizer := bundle.NewLocalizer("zh-Hans")
+	assert.Equal("你好，Yami", localizer.Get("hello", Vars{"name": "Yami"}))
+	assert.Equal("发布", localizer.GetX("Post", "verb"))
+	assert.Equal("Bye", localizer.Get("bye"))
+	assert.Len(bundle.Messages("en"), 3)
+
+	bundle = NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "zh-Hans"),
+	)
+	assert.NoError(bundle.LoadCSV(strings.NewReader(
+		"id\tEnglish\tChinese\tGerman\n"+
+			"hello\tHello\t你好\tHallo\n",
+	), CSVOptions{Comma: '\t', KeyColumn: "id", LocaleColumns: map[string]string{"English": "en", "Chinese": "zh-Hans"}}))
+	assert.Equal("你好", bundle.NewLocalizer("zh-Hans").Get("hello"))
+
+	assert.Error(bundle.LoadCSV(strings.NewReader("name,en\nhello,Hello\n"), CSVOptions{}))
+}
+
+func TestExportCSV(t *testing.T) {
+	assert := assert.New(t)
+
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "zh-Hans"),
+	)
+	assert.NoError(bundle.LoadMessages(map[string]map[string]string{
+		"en":      {"hello": "Hello, {name}", "Post <verb>": "Post", "bye": "Bye"},
+		"zh-Hans": {"hello": "你好，{name}", "Post <verb>": "发布"},
+	}))
+
+	var b strings.Builder
+	assert.NoError(bundle.ExportCSV(&b, CSVOptions{ContextColumn: "context", CommentColumn: "note"}))
+	assert.Equal("key,context,en,zh-Hans,note\n"+
+		"Post,verb,Post,发布,\n"+
+		"bye,,Bye,,\n"+
+		"hello,,\"Hello, {name}\",你好，{name},\n", b.String())
+
+	b.Reset()
+	assert.NoError(bundle.ExportCSV(&b, CSVOptions{Comma: '\t', LocaleColumns: map[string]string{"Chinese": "zh_hans"}}))
+	assert.Equal("key\tChinese\nPost <verb>\t发布\nbye\t\nhello\t你好，{name}\n", b.String())
+
+	// The exported file loads back.
+	b.Reset()
+	assert.NoError(bundle.ExportCSV(&b, CSVOptions{ContextColumn: "context"}))
+	other := NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "zh-Hans"),
+	)
+	assert.NoError(other.LoadCSV(strings.NewReader(b.String()), CSVOptions{ContextColumn: "context"}))
+	assert.Equal(bundle.Messages("zh-Hans"), other.Messages("zh-Hans"))
+}
@@ -0,0 +1,54 @@
+package i18n
+
+import "time"
+
+// FormatDateRange formats the dates of a range with an abbreviated month in the locale, the fields shared by
+// both dates are written once, e.g. `Jan 3–5, 2025` in `en` and `2025年1月3日～5日` in `ja`. A range of a single
+// day is formatted like a date. The end is in the location of the start and the times of day are ignored.
+// The dates are ordered first, a range given from its end is the same range.
+func (localizer *Localizer) FormatDateRange(from, to time.Time) string {
+	tag := localizer.tag()
+	data := lookupLocaleData(tag)
+	calendar := calendarOf(tag)
+
+	to = to.In(from.Location())
+	if to.Before(from) {
+		from, to = to, from
+	}
+	start, end := toCalendarDate(calendar, from), toCalendarDate(calendar, to)
+	var pattern string
+	switch {
+	case start.calendar != end.calendar || start.era != end.era || start.year != end.year:
+		pattern = data.dateRanges[1]
+	case start.month != end.month:
+		pattern = data.dateRanges[2]
+	case start.day != end.day:
+		pattern = data.dateRanges[3]
+	default:
+		return formatDatePattern(tag, from, calendarPattern(data.dateRanges[0], calendar))
+	}
+	first, second := splitRangePattern(pattern)
+	return formatDatePattern(tag, from, calendarPattern(first, calendar)) +
+		formatDatePattern(tag, to, calendarPattern(second, calendar))
+}
+
+// splitRangePattern splits the pattern of a date range before the first field that repeats a field of the start date,
+// e.g. `MMM d–` and `d, y` for `MMM d–d, y`. The letters between single quotes are literals.
+func splitRangePattern(pattern string) (string, string) {
+	seen := make(map[rune]bool)
+	quoted := false
+	var prev rune
+	for i, r := range pattern {
+		switch {
+		case r == '\'':
+			quoted = !quoted
+		case !quoted && (r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z') && r != prev:
+			if seen[r] {
+				return pattern[:i], pattern[i:]
+			}
+			seen[r] = true
+		}
+		prev = r
+	}
+	return pattern, ""
+}
@@ -0,0 +1,54 @@
+package i18n
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatDateRange(t *testing.T) {
+	assert := assert.New(t)
+
+	bundle := newTestParseBundle()
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	en := bundle.NewLocalizer("en")
+	assert.Equal("Jan 3–5, 2025", en.FormatDateRange(date(2025, time.January, 3), date(2025, time.January, 5)))
+	assert.Equal("Jan 3 – Feb 5, 2025", en.FormatDateRange(date(2025, time.January, 3), date(2025, time.February, 5)))
+	assert.Equal("Dec 30, 2025 – Jan 2, 2026", en.FormatDateRange(date(2025, time.December, 30), date(2026, time.January, 2)))
+	assert.Equal("Jan 3, 2025", en.FormatDateRange(date(2025, time.January, 3), date(2025, time.January, 3).Add(5*time.Hour)))
+	// The reversed ranges are ordered.
+	assert.Equal("Jan 3–5, 2025", en.FormatDateRange(date(2025, time.January, 5), date(2025, time.January, 3)))
+	assert.Equal("Dec 30, 2025 – Jan 2, 2026", en.FormatDateRange(date(2026, time.January, 2), date(2025, time.December, 30)))
+	assert.Equal("Jan 3, 2025", en.FormatDateRange(date(2025, time.January, 3).Add(5*time.Hour), date(2025, time.January, 3)))
+
+	ja := bundle.NewLocalizer("ja")
+	assert.Equal("2025年1月3日～5日", ja.FormatDateRange(date(2025, time.January, 3), date(2025, time.January, 5)))
+	assert.Equal("2025年1月3日～2月5日", ja.FormatDateRange(date(2025, time.January, 3), date(2025, time.February, 5)))
+	assert.Equal("2025年12月30日～2026年1月2日", ja.FormatDateRange(date(2025, time.December, 30), date(2026, time.January, 2)))
+
+	assert.Equal("3–5 Jan 2025", bundle.NewLocalizer("en-GB").FormatDateRange(date(2025, time.January, 3), date(2025, time.January, 5)))
+	assert.Equal("3.–5. Jan. 2025", bundle.NewLocalizer("de").FormatDateRange(date(2025, time.January, 3), date(2025, time.January, 5)))
+	assert.Equal("3 janv. – 5 févr. 2025", bundle.NewLocalizer("fr").FormatDateRange(date(2025, time.January, 3), date(2025, time.February, 5)))
+	assert.Equal("3–5 янв. 2025 г.", bundle.NewLocalizer("ru").FormatDateRange(date(2025, time.January, 3), date(2025, time.January, 5)))
+	assert.Equal("2025년 1월 3일~5일", bundle.NewLocalizer("ko").FormatDateRange(date(2025, time.January, 3), date(2025, time.January, 5)))
+
+	// The fields are the ones of the calendar of the localizer.
+	assert.Equal("平成31年4月30日～令和元年5月1日", bundle.NewLocalizer("ja-JP-u-ca-japanese").
+		FormatDateRange(date(2019, time.April, 30), date(2019, time.May, 1)))
+}
+
+func TestSplitRangePattern(t *testing.T) {
+	assert := assert.New(t)
+
+	first, second := splitRangePattern("MMM d–d, y")
+	assert.Equal("MMM d–", first)
+	assert.Equal("d, y", second)
+
+	first, second = splitRangePattern("d 'de' MMM – d 'de' MMM 'de' y")
+	assert.Equal("d 'de' MMM – ", first)
+	assert.Equal("d 'de' MMM 'de' y", second)
+}
@@ -0,0 +1,195 @@
+package i18n
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+// DateStyle is the length of a formatted date or time, or a CLDR pattern like `yyyy-MM-dd`.
+type DateStyle string
+
+// The date and time styles of CLDR.
+const (
+	DateShort  DateStyle = "short"
+	DateMedium DateStyle = "medium"
+	DateLong   DateStyle = "long"
+	DateFull   DateStyle = "full"
+)
+
+// FormatDate formats the date of a time with the CLDR pattern of the style in the locale,
+// e.g. `March 7, 2025` in `en` and `7 mars 2025` in `fr` for `DateLong`.
+// The dates are in the calendar of the localizer, e.g. `令和7年3月7日` in `ja-JP-u-ca-japanese`, see `WithCalendar`.
+func (localizer *Localizer) FormatDate(t time.Time, style DateStyle) string {
+	tag := localizer.tag()
+	return formatDatePattern(tag, t, dateStylePattern(tag, string(style)))
+}
+
+// FormatTime formats the time of day of a time with the CLDR pattern of the style in the locale,
+// e.g. `2:05 PM` in `en` and `14:05` in `fr` for `DateShort`.
+func (localizer *Localizer) FormatTime(t time.Time, style DateStyle) string {
+	tag := localizer.tag()
+	return formatDatePattern(tag, t, datePattern(lookupLocaleData(tag).timeFormats, string(style)))
+}
+
+// dateStyles are the names of the date and time styles, in the order of `localeData.dateFormats`.
+var dateStyles = []string{"full", "long", "medium", "short"}
+
+// datePattern returns the CLDR pattern of a date or time style of the locale, or the style itself
+// if it's not a style name, like `yyyy-MM-dd`. The `medium` pattern is used if the style is empty.
+func datePattern(patterns [4]string, style string) string {
+	if style == "" {
+		style = "medium"
+	}
+	for i, name := range dateStyles {
+		if style == name {
+			return patterns[i]
+		}
+	}
+	return style
+}
+
+// formatDatePattern formats a time with a CLDR date pattern of the locale, like `EEEE, MMMM d, y`.
+// The letters between single quotes are literals, two single quotes are a quote.
+// The date fields are in the calendar of the `ca` key of the tag.
+func formatDatePattern(tag language.Tag, t time.Time, pattern string) string {
+	data := lookupLocaleData(tag)
+	date := toCalendarDate(calendarOf(tag), t)
+	base, _ := tag.Base()
+	var b strings.Builder
+	runes := []rune(pattern)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		if r == '\'' {
+			if i+1 < len(runes) && runes[i+1] == '\'' {
+				b.WriteRune('\'')
+				i += 2
+				continue
+			}
+			for i++; i < len(runes); i++ {
+				if runes[i] == '\'' {
+					if i+1 < len(runes) && runes[i+1] == '\'' {
+						i++
+					} else {
+						break
+					}
+				}
+				b.WriteRune(runes[i])
+			}
+			i++
+			continue
+		}
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z') {
+			b.WriteRune(r)
+			i++
+			continue
+		}
+		n := 1
+		for i+n < len(runes) && runes[i+n] == r {
+			n++
+		}
+		if r == 'y' && date.calendar == CalendarJapanese && date.year == 1 && base.String() == "ja" &&
+			i+n < len(runes) && runes[i+n] == '年' {
+			// The first year of a Japanese era is `元年` in Japanese.
+			b.WriteString("元")
+		} else {
+			b.WriteString(formatDateField(data, t, date, r, n))
+		}
+		i += n
+	}
+	return b.String()
+}
+
+// formatDateField formats a field of a date pattern, the letter repeated n times like `MMM`.
+// The era, year, month and day are the ones of the date in its calendar.
+func formatDateField(data *localeData, t time.Time, date calendarDate, letter rune, n int) string {
+	switch letter {
+	case 'G':
+		return eraName(data, date, n)
+	case 'y':
+		if n == 2 {
+			return padNumber(date.year%100, 2)
+		}
+		return padNumber(date.year, n)
+	case 'M', 'L':
+		months, abbrMonths := data.months, data.abbrMonths
+		if date.calendar == CalendarIslamic {
+			months, abbrMonths = islamicMonths, islamicAbbrMonths
+		}
+		switch {
+		case n >= 4:
+			return months[date.month-1]
+		case n == 3:
+			return abbrMonths[date.month-1]
+		}
+		return padNumber(date.month, n)
+	case 'd':
+		return padNumber(date.day, n)
+	case 'E':
+		if n >= 4 {
+			return data.weekdays[t.Weekday()]
+		}
+		return data.abbrWeekdays[t.Weekday()]
+	case 'a':
+		periods := data.dayPeriods
+		if periods[0] == "" {
+			periods = [2]string{"AM", "PM"}
+		}
+		return periods[t.Hour()/12]
+	case 'h':
+		h := t.Hour() % 12
+		if h == 0 {
+			h = 12
+		}
+		return padNumber(h, n)
+	case 'H':
+		return padNumber(t.Hour(), n)
+	case 'm':
+		return padNumber(t.Minute(), n)
+	case 's':
+		return padNumber(t.Second(), n)
+	case 'S':
+		fraction := padNumber(t.Nanosecond(), 9)
+		return fraction[:min(n, 9)]
+	case 'z':
+		zone, _ := t.Zone()
+		if zone == "" || zone[0] == '+' || zone[0] == '-' {
+			// The zones without an abbreviation are written like `GMT+08:00`.
+			return "GMT" + t.Format("-07:00")
+		}
+		return zone
+	}
+	return strings.Repeat(string(letter), n)
+}
+
+// padNumber formats a non-negative number with at least n digits.
+func padNumber(v, n int) string {
+	s := strconv.Itoa(v)
+	if len(s) < n {
+		s = strings.Repeat("0", n-len(s)) + s
+	}
+	return s
+}
+
+// dateValue converts the value of a date argument to a time, the numbers are Unix milliseconds like in ICU.
+func dateValue(v any) (time.Time, bool) {
+	switch v := v.(type) {
+	case time.Time:
+		return v, true
+	case *time.Time:
+		if v != nil {
+			return *v, true
+		}
+	case calendarTime:
+		return v.Time, true
+	case int:
+		return time.UnixMilli(int64(v)), true
+	case int64:
+		return time.UnixMilli(v), true
+	case float64:
+		return time.UnixMilli(int64(v)), true
+	}
+	return time.Time{}, false
+}
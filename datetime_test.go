@@ -0,0 +1,77 @@
+package i18n
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+)
+
+func TestFormatDatePattern(t *testing.T) {
+	assert := assert.New(t)
+	when := time.Date(2025, time.March, 7, 14, 5, 9, 0, time.UTC)
+
+	assert.Equal("Friday, March 7, 2025", formatDatePattern(language.English, when, "EEEE, MMMM d, y"))
+	assert.Equal("2:05 PM", formatDatePattern(language.English, when, "h:mm a"))
+	assert.Equal("25-03-07T14:05:09 UTC", formatDatePattern(language.English, when, "yy-MM-dd'T'HH:mm:ss z"))
+	assert.Equal("o'clock 2", formatDatePattern(language.English, when, "'o''clock' h"))
+	assert.Equal("2025年3月7日星期五", formatDatePattern(language.Make("zh-Hans"), when, "y年M月d日EEEE"))
+	assert.Equal("오후 2:05", formatDatePattern(language.Korean, when, "a h:mm"))
+	assert.Equal("GMT+08:00", formatDatePattern(language.English, when.In(time.FixedZone("", 8*3600)), "z"))
+}
+
+func TestDateArguments(t *testing.T) {
+	assert := assert.New(t)
+
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "en-GB", "de", "zh-Hans"),
+	)
+	assert.NoError(bundle.LoadMessages(map[string]map[string]string{
+		"en": {
+			"due":     "Due {when, date, long} at {when, time, short}",
+			"created": "Created {when, date}",
+			"iso":     "{when, date, yyyy-MM-dd}",
+		},
+		"en-GB":   {"due": "Due {when, date, long} at {when, time, short}"},
+		"de":      {"due": "Fällig am {when, date, full} um {when, time, short}"},
+		"zh-Hans": {"due": "截止于{when, date, long} {when, time, short}"},
+	}))
+	vars := Vars{"when": time.Date(2025, time.March, 7, 14, 5, 0, 0, time.UTC)}
+
+	assert.Equal("Due March 7, 2025 at 2:05 PM", bundle.NewLocalizer("en").Get("due", vars))
+	assert.Equal("Due 7 March 2025 at 14:05", bundle.NewLocalizer("en-GB").Get("due", vars))
+	assert.Equal("Fällig am Freitag, 7. März 2025 um 14:05", bundle.NewLocalizer("de").Get("due", vars))
+	assert.Equal("截止于2025年3月7日 14:05", bundle.NewLocalizer("zh-Hans").Get("due", vars))
+	assert.Equal("Created Mar 7, 2025", bundle.NewLocalizer("en").Get("created", vars))
+	assert.Equal("2025-03-07", bundle.NewLocalizer("en").Get("iso", vars))
+	assert.Equal("1970-01-02", bundle.NewLocalizer("en").Get("iso", Vars{"when": 86400000}))
+
+	_, err := bundle.NewLocalizer("en").GetE("iso", Vars{"when": "yesterday"})
+	assert.ErrorIs(err, ErrFormatMessage)
+	assert.NoError(ValidateMessage("{when, date, short} {when, time}"))
+}
+
+func TestFormatDate(t *testing.T) {
+	assert := assert.New(t)
+
+	bundle := newTestParseBundle()
+	when := time.Date(2025, time.March, 7, 14, 5, 9, 0, time.UTC)
+
+	en := bundle.NewLocalizer("en")
+	assert.Equal("3/7/25", en.FormatDate(when, DateShort))
+	assert.Equal("Mar 7, 2025", en.FormatDate(when, DateMedium))
+	assert.Equal("Friday, March 7, 2025", en.FormatDate(when, DateFull))
+	assert.Equal("2:05 PM", en.FormatTime(when, DateShort))
+	assert.Equal("2:05:09 PM UTC", en.FormatTime(when, DateLong))
+	assert.Equal("2025/03", en.FormatDate(when, "y/MM"))
+
+	assert.Equal("07/03/2025", bundle.NewLocalizer("en-GB").FormatDate(when, DateShort))
+	assert.Equal("14:05", bundle.NewLocalizer("en-GB").FormatTime(when, DateShort))
+	assert.Equal("vendredi 7 mars 2025", bundle.NewLocalizer("fr").FormatDate(when, DateFull))
+	assert.Equal("2025年3月7日", bundle.NewLocalizer("ja").FormatDate(when, DateLong))
+
+	// The locales without built-in data are formatted in English, see `HasLocaleData`.
+	assert.Equal("March 7, 2025", bundle.NewLocalizer("ar").FormatDate(when, DateLong))
+}
@@ -0,0 +1,66 @@
+package i18n
+
+import (
+	"strings"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/language/display"
+)
+
+// LanguageName returns the name of a language in the localizer's locale, e.g. `German` in `en` and `德语` in `zh`
+// for `de`, or the locale itself if it's invalid. The names are the English ones for the locales without names.
+func (localizer *Localizer) LanguageName(locale string) string {
+	tag, err := language.Parse(locale)
+	if err != nil {
+		return locale
+	}
+	namer := display.Languages(localizer.tag())
+	if namer == nil {
+		namer = display.English.Languages()
+	}
+	if name := namer.Name(tag); name != "" {
+		return name
+	}
+	return locale
+}
+
+// NativeLanguageName returns the name of a language in itself, like `Deutsch` for `de` and `简体中文` for `zh-Hans`,
+// for the language pickers. The locale itself is returned if it's invalid or has no name.
+func NativeLanguageName(locale string) string {
+	tag, err := language.Parse(locale)
+	if err != nil {
+		return locale
+	}
+	if name := display.Self.Name(tag); name != "" {
+		return name
+	}
+	return locale
+}
+
+// RegionName returns the name of a region by its ISO 3166-1 or UN M.49 code in the localizer's locale,
+// e.g. `Germany` in `en` and `Deutschland` in `de` for `DE`, or the code itself if it's unknown.
+func (localizer *Localizer) RegionName(code string) string {
+	region, err := language.ParseRegion(code)
+	if err != nil {
+		return code
+	}
+	namer := display.Regions(localizer.tag())
+	if namer == nil {
+		namer = display.English.Regions()
+	}
+	if name := namer.Name(region); name != "" {
+		return name
+	}
+	return code
+}
+
+// CurrencyName returns the name of a currency by its ISO 4217 code in the localizer's locale,
+// e.g. `US Dollar` in `en` and `美元` in `zh` for `USD`. The names are available for the major currencies
+// (USD, EUR, GBP, JPY and CNY), the code itself is returned for the others.
+func (localizer *Localizer) CurrencyName(code string) string {
+	code = strings.ToUpper(code)
+	if name, ok := lookupLocaleData(localizer.tag()).currencies[code]; ok {
+		return name
+	}
+	return code
+}
@@ -0,0 +1,43 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLanguageName(t *testing.T) {
+	assert := assert.New(t)
+	bundle := newTestParseBundle()
+
+	assert.Equal("German", bundle.NewLocalizer("en").LanguageName("de"))
+	assert.Equal("Simplified Chinese", bundle.NewLocalizer("en").LanguageName("zh-Hans"))
+	assert.Equal("德语", bundle.NewLocalizer("zh-Hans").LanguageName("de"))
+	assert.Equal("Chinesisch (vereinfacht)", bundle.NewLocalizer("de").LanguageName("zh-Hans"))
+	assert.Equal("not a locale", bundle.NewLocalizer("en").LanguageName("not a locale"))
+
+	assert.Equal("Deutsch", NativeLanguageName("de"))
+	assert.Equal("简体中文", NativeLanguageName("zh-Hans"))
+	assert.Equal("British English", NativeLanguageName("en-GB"))
+}
+
+func TestRegionName(t *testing.T) {
+	assert := assert.New(t)
+	bundle := newTestParseBundle()
+
+	assert.Equal("Germany", bundle.NewLocalizer("en").RegionName("DE"))
+	assert.Equal("Deutschland", bundle.NewLocalizer("de").RegionName("de"))
+	assert.Equal("日本", bundle.NewLocalizer("ja").RegionName("JP"))
+	assert.Equal("XYZ", bundle.NewLocalizer("en").RegionName("XYZ"))
+}
+
+func TestCurrencyName(t *testing.T) {
+	assert := assert.New(t)
+	bundle := newTestParseBundle()
+
+	assert.Equal("US Dollar", bundle.NewLocalizer("en").CurrencyName("USD"))
+	assert.Equal("美元", bundle.NewLocalizer("zh-Hans").CurrencyName("usd"))
+	assert.Equal("Euro", bundle.NewLocalizer("de-CH").CurrencyName("EUR"))
+	assert.Equal("Japanese Yen", bundle.NewLocalizer("ar").CurrencyName("JPY"))
+	assert.Equal("CHF", bundle.NewLocalizer("en").CurrencyName("CHF"))
+}
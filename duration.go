@@ -0,0 +1,95 @@
+package i18n
+
+import (
+	"strings"
+	"time"
+)
+
+// DurationOptions are the options of `FormatDuration`.
+type DurationOptions struct {
+	// Width is the width of the unit names, `UnitShort` if empty.
+	Width UnitWidth
+	// LargestUnit is the largest unit, e.g. `time.Hour` to format 2 days as `48 hr`. A day if 0.
+	LargestUnit time.Duration
+	// SmallestUnit is the smallest unit, the duration is rounded to it. A second if 0.
+	SmallestUnit time.Duration
+	// MaxUnits is the maximum number of units from the largest nonzero one, the duration is rounded to the last one.
+	// All the units if 0.
+	MaxUnits int
+}
+
+// durationUnits are the units of the durations, by their CLDR names.
+var durationUnits = []struct {
+	name string
+	unit time.Duration
+}{
+	{"day", 24 * time.Hour},
+	{"hour", time.Hour},
+	{"minute", time.Minute},
+	{"second", time.Second},
+	{"millisecond", time.Millisecond},
+}
+
+// FormatDuration formats a duration with the unit names of the locale, e.g. `2 hr, 30 min` in `en`
+// and `2小时30分钟` in `zh` for 150 minutes. The zero units are left out, like the minutes of `1 day, 2 hr`.
+func (localizer *Localizer) FormatDuration(d time.Duration, opts *DurationOptions) string {
+	if opts == nil {
+		opts = &DurationOptions{}
+	}
+	largest, smallest := opts.LargestUnit, opts.SmallestUnit
+	if largest == 0 {
+		largest = durationUnits[0].unit
+	}
+	if smallest == 0 {
+		smallest = time.Second
+	}
+	first, last := -1, -1
+	for i, u := range durationUnits {
+		if u.unit <= largest && u.unit >= smallest {
+			if first < 0 {
+				first = i
+			}
+			last = i
+		}
+	}
+	if first < 0 {
+		first, last = len(durationUnits)-1, len(durationUnits)-1
+	}
+
+	negative := d < 0
+	if negative {
+		d = -d
+	}
+	// The rounding can carry into a larger unit, like 59.6 seconds to 1 minute, which moves the last unit.
+	lead, end := last, last
+	for range 2 {
+		lead = last
+		for i := first; i < last; i++ {
+			if d >= durationUnits[i].unit {
+				lead = i
+				break
+			}
+		}
+		if end = last; opts.MaxUnits > 0 {
+			end = min(last, lead+opts.MaxUnits-1)
+		}
+		d = d.Round(durationUnits[end].unit)
+	}
+
+	tag := localizer.tag()
+	data := lookupLocaleData(tag)
+	var parts []string
+	for i := lead; i <= end; i++ {
+		u := durationUnits[i]
+		v := int64(d / u.unit)
+		d -= time.Duration(v) * u.unit
+		if v == 0 && (len(parts) > 0 || i < end) {
+			continue
+		}
+		if negative && len(parts) == 0 {
+			v = -v
+		}
+		parts = append(parts, localizer.formatUnit(tag, data, u.name, opts.Width, v))
+	}
+	return strings.Join(parts, data.unitSeparators[opts.Width.index()])
+}
@@ -0,0 +1,41 @@
+package i18n
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatDuration(t *testing.T) {
+	assert := assert.New(t)
+	bundle := newTestParseBundle()
+
+	cases := []struct {
+		locale   string
+		duration time.Duration
+		opts     *DurationOptions
+		want     string
+	}{
+		{"en", 150 * time.Minute, nil, "2 hr, 30 min"},
+		{"en", 150 * time.Minute, &DurationOptions{Width: UnitLong}, "2 hours, 30 minutes"},
+		{"en", 150 * time.Minute, &DurationOptions{Width: UnitNarrow}, "2h 30m"},
+		{"en", time.Hour, &DurationOptions{Width: UnitLong}, "1 hour"},
+		{"en", 26*time.Hour + 5*time.Second, nil, "1 day, 2 hr, 5 sec"},
+		{"en", 26 * time.Hour, &DurationOptions{LargestUnit: time.Hour}, "26 hr"},
+		{"en", 2*time.Hour + 40*time.Minute + 10*time.Second, &DurationOptions{MaxUnits: 1}, "3 hr"},
+		{"en", 59*time.Minute + 59*time.Second, &DurationOptions{MaxUnits: 1}, "1 hr"},
+		{"en", 1500 * time.Millisecond, &DurationOptions{SmallestUnit: time.Millisecond}, "1 sec, 500 ms"},
+		{"en", 0, nil, "0 sec"},
+		{"en", -90 * time.Second, nil, "-1 min, 30 sec"},
+		{"de", 150 * time.Minute, &DurationOptions{Width: UnitLong}, "2 Stunden, 30 Minuten"},
+		{"fr", 150 * time.Minute, nil, "2\u00a0h 30\u00a0min"},
+		{"ru", 5 * time.Hour, &DurationOptions{Width: UnitLong}, "5 часов"},
+		{"ru", 22 * time.Hour, &DurationOptions{Width: UnitLong}, "22 часа"},
+		{"zh-Hans", 150 * time.Minute, nil, "2小时30分钟"},
+		{"ja", 150 * time.Minute, &DurationOptions{Width: UnitNarrow}, "2時間 30分"},
+	}
+	for _, c := range cases {
+		assert.Equal(c.want, bundle.NewLocalizer(c.locale).FormatDuration(c.duration, c.opts), "%s %v", c.locale, c.duration)
+	}
+}
@@ -0,0 +1,4 @@
+// Package locales is the Go code generated from the translation files by `i18n-gen`.
+package locales
+
+//go:generate go run ../../../cmd/i18n-gen -o messages_gen.go *.json
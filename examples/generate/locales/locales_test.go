@@ -0,0 +1,45 @@
+package locales
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kaptinlin/go-i18n"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFuncs(t *testing.T) {
+	assert := assert.New(t)
+
+	generated := i18n.NewBundle(i18n.WithDefaultLocale("en"), i18n.WithLocales("en", "ru"))
+	assert.NoError(Load(generated))
+	parsed := i18n.NewBundle(i18n.WithDefaultLocale("en"), i18n.WithLocales("en", "ru"))
+	assert.NoError(parsed.LoadMessages(Messages))
+
+	samples := []i18n.Vars{
+		{},
+		{"name": "Ann", "count": 0, "floor": 1, "gender": "female", "host": "Ann", "guest": "Bob", "guests": 0},
+		{"count": 1, "floor": 2, "gender": "male", "guests": 1, "share": 0.25, "total": 1234567},
+		{"count": 3, "floor": 3, "gender": "x", "guests": 2, "guest": "Bob"},
+		{"count": 5.5, "floor": 11, "guests": 5, "guest": "Bob", "when": time.Date(2025, time.March, 7, 14, 5, 0, 0, time.UTC)},
+		{"count": "21", "floor": "22", "guests": "3", "name": 42},
+		{"count": int64(12), "floor": uint8(23), "guests": float32(4)},
+		{"count": true, "floor": []int{1}, "when": "today", "share": "half"},
+	}
+	for locale, messages := range Messages {
+		g, p := generated.NewLocalizer(locale), parsed.NewLocalizer(locale)
+		for name := range messages {
+			for _, vars := range samples {
+				want, wantErr := p.GetE(name, vars)
+				got, err := g.GetE(name, vars)
+				assert.Equal(want, got, "%s %s %v", locale, name, vars)
+				assert.Equal(wantErr != nil, err != nil, "%s %s %v: %v", locale, name, vars, wantErr)
+			}
+		}
+	}
+
+	assert.Equal("Ann invited Bob and 2 others.", generated.NewLocalizer("en").Get(MsgGuests, i18n.Vars{
+		"host": "Ann", "guest": "Bob", "guests": 3,
+	}))
+	assert.Equal("5 сообщений", generated.NewLocalizer("ru").Get(MsgMessages, i18n.Vars{"count": 5}))
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/kaptinlin/go-i18n"
+	"github.com/kaptinlin/go-i18n/examples/generate/locales"
+)
+
+func main() {
+	bundle := i18n.NewBundle(
+		i18n.WithDefaultLocale("en"),
+		i18n.WithLocales("en", "ru"),
+	)
+
+	// The messages are formatted by the generated functions, they're never parsed.
+	if err := locales.Load(bundle); err != nil {
+		fmt.Println(err)
+	}
+
+	localizer := bundle.NewLocalizer("ru")
+
+	// Output: Привет, John
+	fmt.Println(localizer.Get(locales.MsgHelloName, i18n.Vars{
+		"name": "John",
+	}))
+
+	// Output: 5 сообщений
+	fmt.Println(localizer.Get(locales.MsgMessages, i18n.Vars{
+		"count": 5,
+	}))
+}
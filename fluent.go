@@ -0,0 +1,549 @@
+package i18n
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ErrInvalidFluent is returned when a Fluent file cannot be parsed.
+var ErrInvalidFluent = errors.New("i18n: invalid fluent file")
+
+// UnmarshalFTL is an `Unmarshaler` for the Mozilla Fluent (FTL) files, to be used with `WithUnmarshaler`.
+//
+// The messages are converted to ICU MessageFormat, so they coexist with the other catalogs:
+// the variables (`{ $name }`) become arguments, the selectors become `plural` arguments if their variant keys
+// are CLDR categories or numbers and `select` arguments otherwise, the attributes are named `message.attribute`,
+// and the terms (`-brand`) and the message references are inlined. The functions like `NUMBER($n)` are
+// reduced to their variable.
+func UnmarshalFTL(data []byte, v any) error {
+	out, ok := v.(*map[string]string)
+	if !ok {
+		return fmt.Errorf("%w: unsupported target %T", ErrInvalidFluent, v)
+	}
+	if *out == nil {
+		*out = make(map[string]string)
+	}
+
+	r, err := parseFTL(string(data))
+	if err != nil {
+		return err
+	}
+	for _, id := range r.order {
+		if strings.HasPrefix(id, "-") {
+			continue
+		}
+		entry := r.entries[id]
+		if entry.value != nil {
+			(*out)[id] = r.render(entry.value, 0)
+		}
+		for _, attr := range entry.attrOrder {
+			(*out)[id+"."+attr] = r.render(entry.attrs[attr], 0)
+		}
+	}
+	return nil
+}
+
+// ftlNode is a node of a Fluent pattern.
+type ftlNode struct {
+	// text is a literal text if no other field is set.
+	text string
+	// variable is the name of a `$variable`.
+	variable string
+	// ref is the id of a referenced message or term, and attr its attribute.
+	ref  string
+	attr string
+	// selector is the node a select expression selects on, and variants its variants.
+	selector *ftlNode
+	variants []ftlVariant
+}
+
+// ftlVariant is a variant of a select expression.
+type ftlVariant struct {
+	key     string
+	def     bool
+	pattern []ftlNode
+}
+
+// ftlEntry is a message or a term.
+type ftlEntry struct {
+	value     []ftlNode
+	attrs     map[string][]ftlNode
+	attrOrder []string
+}
+
+// ftlResource is a parsed Fluent file.
+type ftlResource struct {
+	entries map[string]*ftlEntry
+	order   []string
+}
+
+// parseFTL parses the entries of a Fluent file.
+func parseFTL(src string) (*ftlResource, error) {
+	r := &ftlResource{entries: make(map[string]*ftlEntry)}
+	lines := strings.Split(strings.ReplaceAll(src, "\r\n", "\n"), "\n")
+
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+		if strings.TrimSpace(line) == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if line[0] == ' ' || line[0] == '\t' {
+			return nil, fmt.Errorf("%w: unexpected indentation at line %d", ErrInvalidFluent, i+1)
+		}
+		id, value, ok := strings.Cut(line, "=")
+		id = strings.TrimSpace(id)
+		if !ok || !isFTLIdentifier(strings.TrimPrefix(id, "-")) {
+			return nil, fmt.Errorf("%w: invalid entry at line %d", ErrInvalidFluent, i+1)
+		}
+
+		// The entry continues on the indented lines and the closing braces, the blank lines in between are kept.
+		j := i + 1
+		for k := j; k < len(lines); k++ {
+			if strings.TrimSpace(lines[k]) == "" {
+				continue
+			}
+			if c := lines[k][0]; c != ' ' && c != '\t' && c != '}' {
+				break
+			}
+			j = k + 1
+		}
+		block := lines[i+1 : j]
+		i = j - 1
+
+		entry := &ftlEntry{attrs: make(map[string][]ftlNode)}
+		// The attributes start with an indented `.name =`.
+		valueLines := []string{value}
+		var attr string
+		var attrLines []string
+		flushAttr := func() error {
+			if attr == "" {
+				return nil
+			}
+			pattern, err := parseFTLPattern(attrLines)
+			if err != nil {
+				return err
+			}
+			entry.attrs[attr] = pattern
+			entry.attrOrder = append(entry.attrOrder, attr)
+			return nil
+		}
+		for _, l := range block {
+			trimmed := strings.TrimSpace(l)
+			if name, v, ok := strings.Cut(trimmed, "="); ok && strings.HasPrefix(trimmed, ".") && isFTLIdentifier(strings.TrimSpace(name[1:])) {
+				if err := flushAttr(); err != nil {
+					return nil, err
+				}
+				attr, attrLines = strings.TrimSpace(name[1:]), []string{v}
+				continue
+			}
+			if attr != "" {
+				attrLines = append(attrLines, l)
+			} else {
+				valueLines = append(valueLines, l)
+			}
+		}
+		if err := flushAttr(); err != nil {
+			return nil, err
+		}
+		if strings.TrimSpace(strings.Join(valueLines, "")) != "" {
+			pattern, err := parseFTLPattern(valueLines)
+			if err != nil {
+				return nil, err
+			}
+			entry.value = pattern
+		}
+		if _, ok := r.entries[id]; !ok {
+			r.order = append(r.order, id)
+		}
+		r.entries[id] = entry
+	}
+	return r, nil
+}
+
+// isFTLIdentifier
+func isFTLIdentifier(s string) bool {
+	for i, c := range s {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || i > 0 && (c >= '0' && c <= '9' || c == '_' || c == '-')) {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// parseFTLPattern parses the lines of a pattern, the common indentation of the continuation lines is removed.
+func parseFTLPattern(lines []string) ([]ftlNode, error) {
+	indent := -1
+	for _, l := range lines[1:] {
+		if strings.TrimSpace(l) == "" || l[0] == '}' {
+			continue
+		}
+		n := len(l) - len(strings.TrimLeft(l, " \t"))
+		if indent < 0 || n < indent {
+			indent = n
+		}
+	}
+	text := strings.TrimLeft(lines[0], " ")
+	for _, l := range lines[1:] {
+		if indent >= 0 && len(l) >= indent && strings.TrimSpace(l[:indent]) == "" {
+			l = l[indent:]
+		}
+		text += "\n" + l
+	}
+	if strings.TrimSpace(lines[0]) == "" {
+		text = strings.TrimLeft(text, "\n")
+	}
+	text = strings.TrimRight(text, " \n")
+
+	p := &ftlParser{input: []rune(text)}
+	nodes, err := p.pattern(false)
+	if err == nil && p.pos < len(p.input) {
+		err = fmt.Errorf("unexpected %q", string(p.input[p.pos]))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v in %q", ErrInvalidFluent, err, text)
+	}
+	return nodes, nil
+}
+
+// ftlParser parses the patterns.
+type ftlParser struct {
+	input []rune
+	pos   int
+}
+
+// pattern parses the text and the placeables until the end, or the end of a variant.
+func (p *ftlParser) pattern(variant bool) ([]ftlNode, error) {
+	var nodes []ftlNode
+	var text strings.Builder
+	flush := func() {
+		if text.Len() > 0 {
+			nodes = append(nodes, ftlNode{text: text.String()})
+			text.Reset()
+		}
+	}
+	for p.pos < len(p.input) {
+		c := p.input[p.pos]
+		if variant && (c == '}' || c == '\n' && p.variantAhead()) {
+			break
+		}
+		if c == '{' {
+			p.pos++
+			node, err := p.placeable()
+			if err != nil {
+				return nil, err
+			}
+			flush()
+			nodes = append(nodes, node)
+			continue
+		}
+		if c == '}' {
+			return nil, errors.New("unbalanced }")
+		}
+		text.WriteRune(c)
+		p.pos++
+	}
+	flush()
+	return nodes, nil
+}
+
+// variantAhead reports whether the next line starts a variant.
+func (p *ftlParser) variantAhead() bool {
+	i := p.pos + 1
+	for i < len(p.input) && (p.input[i] == ' ' || p.input[i] == '\t') {
+		i++
+	}
+	return i < len(p.input) && (p.input[i] == '[' || p.input[i] == '*' || p.input[i] == '}')
+}
+
+// skipBlank
+func (p *ftlParser) skipBlank() {
+	for p.pos < len(p.input) && unicode.IsSpace(p.input[p.pos]) {
+		p.pos++
+	}
+}
+
+// placeable parses a placeable after its opening brace.
+func (p *ftlParser) placeable() (ftlNode, error) {
+	p.skipBlank()
+	node, err := p.expression()
+	if err != nil {
+		return node, err
+	}
+	p.skipBlank()
+	if strings.HasPrefix(string(p.input[p.pos:min(p.pos+2, len(p.input))]), "->") {
+		p.pos += 2
+		selector := node
+		node = ftlNode{selector: &selector}
+		for {
+			p.skipBlank()
+			if p.pos >= len(p.input) {
+				return node, errors.New("unterminated select expression")
+			}
+			if p.input[p.pos] == '}' {
+				break
+			}
+			var v ftlVariant
+			if p.input[p.pos] == '*' {
+				v.def = true
+				p.pos++
+			}
+			if p.pos >= len(p.input) || p.input[p.pos] != '[' {
+				return node, errors.New("invalid variant")
+			}
+			end := p.pos
+			for end < len(p.input) && p.input[end] != ']' {
+				end++
+			}
+			if end == len(p.input) {
+				return node, errors.New("unterminated variant key")
+			}
+			v.key = strings.TrimSpace(string(p.input[p.pos+1 : end]))
+			p.pos = end + 1
+			for p.pos < len(p.input) && (p.input[p.pos] == ' ' || p.input[p.pos] == '\t') {
+				p.pos++
+			}
+			pattern, err := p.pattern(true)
+			if err != nil {
+				return node, err
+			}
+			v.pattern = trimFTLPattern(pattern)
+			node.variants = append(node.variants, v)
+		}
+	}
+	if p.pos >= len(p.input) || p.input[p.pos] != '}' {
+		return node, errors.New("unterminated placeable")
+	}
+	p.pos++
+	return node, nil
+}
+
+// expression parses a variable, a reference, a literal or a function call.
+func (p *ftlParser) expression() (ftlNode, error) {
+	if p.pos >= len(p.input) {
+		return ftlNode{}, errors.New("unterminated placeable")
+	}
+	switch c := p.input[p.pos]; {
+	case c == '$':
+		p.pos++
+		return ftlNode{variable: p.identifier()}, nil
+	case c == '"':
+		p.pos++
+		var b strings.Builder
+		for p.pos < len(p.input) && p.input[p.pos] != '"' {
+			if p.input[p.pos] == '\\' && p.pos+1 < len(p.input) {
+				p.pos++
+			}
+			b.WriteRune(p.input[p.pos])
+			p.pos++
+		}
+		if p.pos == len(p.input) {
+			return ftlNode{}, errors.New("unterminated string literal")
+		}
+		p.pos++
+		return ftlNode{text: b.String()}, nil
+	case c == '-' && p.pos+1 < len(p.input) && unicode.IsDigit(p.input[p.pos+1]), unicode.IsDigit(c):
+		start := p.pos
+		p.pos++
+		for p.pos < len(p.input) && (unicode.IsDigit(p.input[p.pos]) || p.input[p.pos] == '.') {
+			p.pos++
+		}
+		return ftlNode{text: string(p.input[start:p.pos])}, nil
+	case c == '-' || unicode.IsLetter(c):
+		term := c == '-'
+		if term {
+			p.pos++
+		}
+		id := p.identifier()
+		if term {
+			id = "-" + id
+		}
+		node := ftlNode{ref: id}
+		if p.pos < len(p.input) && p.input[p.pos] == '.' {
+			p.pos++
+			node.attr = p.identifier()
+		}
+		if p.pos < len(p.input) && p.input[p.pos] == '(' {
+			// The arguments of a function, or the parameters of a term.
+			args, err := p.arguments()
+			if err != nil {
+				return node, err
+			}
+			if !term {
+				if len(args) == 0 {
+					return ftlNode{}, fmt.Errorf("function %s without argument", id)
+				}
+				return args[0], nil
+			}
+		}
+		return node, nil
+	default:
+		return ftlNode{}, fmt.Errorf("unexpected %q", c)
+	}
+}
+
+// arguments parses the positional arguments of a call, the named ones are skipped.
+func (p *ftlParser) arguments() ([]ftlNode, error) {
+	p.pos++
+	var args []ftlNode
+	for {
+		p.skipBlank()
+		if p.pos >= len(p.input) {
+			return nil, errors.New("unterminated call")
+		}
+		if p.input[p.pos] == ')' {
+			p.pos++
+			return args, nil
+		}
+		arg, err := p.expression()
+		if err != nil {
+			return nil, err
+		}
+		p.skipBlank()
+		if p.pos < len(p.input) && p.input[p.pos] == ':' {
+			p.pos++
+			p.skipBlank()
+			if _, err := p.expression(); err != nil {
+				return nil, err
+			}
+		} else {
+			args = append(args, arg)
+		}
+		p.skipBlank()
+		if p.pos < len(p.input) && p.input[p.pos] == ',' {
+			p.pos++
+		}
+	}
+}
+
+// identifier
+func (p *ftlParser) identifier() string {
+	start := p.pos
+	for p.pos < len(p.input) {
+		c := p.input[p.pos]
+		if !(unicode.IsLetter(c) || unicode.IsDigit(c) || c == '_' || c == '-') {
+			break
+		}
+		p.pos++
+	}
+	return string(p.input[start:p.pos])
+}
+
+// trimFTLPattern trims the blanks around a variant pattern.
+func trimFTLPattern(nodes []ftlNode) []ftlNode {
+	if n := len(nodes); n > 0 && nodes[n-1].isText() {
+		nodes[n-1].text = strings.TrimRight(nodes[n-1].text, " \t\n")
+	}
+	if len(nodes) > 0 && nodes[0].isText() {
+		nodes[0].text = strings.TrimLeft(nodes[0].text, " \t\n")
+	}
+	return nodes
+}
+
+// isText
+func (n *ftlNode) isText() bool {
+	return n.variable == "" && n.ref == "" && n.selector == nil
+}
+
+// render converts a pattern to ICU MessageFormat, the references are inlined.
+func (r *ftlResource) render(nodes []ftlNode, depth int) string {
+	if depth > 16 {
+		// A reference cycle.
+		return ""
+	}
+	var b strings.Builder
+	for _, n := range nodes {
+		switch {
+		case n.selector != nil:
+			b.WriteString(r.renderSelect(n, depth))
+		case n.variable != "":
+			b.WriteString("{" + n.variable + "}")
+		case n.ref != "":
+			b.WriteString(r.render(r.resolve(n), depth+1))
+		default:
+			b.WriteString(escapeICU(n.text))
+		}
+	}
+	return b.String()
+}
+
+// resolve returns the pattern of a reference, the unknown references are kept as arguments.
+func (r *ftlResource) resolve(n ftlNode) []ftlNode {
+	entry, ok := r.entries[n.ref]
+	if !ok {
+		return []ftlNode{{text: "{" + n.ref + "}"}}
+	}
+	if n.attr != "" {
+		return entry.attrs[n.attr]
+	}
+	return entry.value
+}
+
+// renderSelect converts a select expression to an ICU `plural` or `select` argument.
+func (r *ftlResource) renderSelect(n ftlNode, depth int) string {
+	selector := *n.selector
+	if selector.variable == "" {
+		// A literal or a term attribute selects the variant statically.
+		value := selector.text
+		if selector.ref != "" {
+			value = r.render(r.resolve(selector), depth+1)
+		}
+		var def []ftlNode
+		for _, v := range n.variants {
+			if v.key == value {
+				return r.render(v.pattern, depth+1)
+			}
+			if v.def {
+				def = v.pattern
+			}
+		}
+		return r.render(def, depth+1)
+	}
+
+	typ := "plural"
+	for _, v := range n.variants {
+		if _, err := strconv.ParseFloat(v.key, 64); err != nil && !isPluralCategory(v.key) {
+			typ = "select"
+		}
+	}
+	var b strings.Builder
+	b.WriteString("{" + selector.variable + ", " + typ + ",")
+	var def *ftlVariant
+	hasOther := false
+	for i, v := range n.variants {
+		key := v.key
+		if _, err := strconv.ParseFloat(key, 64); err == nil && typ == "plural" {
+			key = "=" + key
+		}
+		hasOther = hasOther || key == "other"
+		if v.def {
+			def = &n.variants[i]
+		}
+		b.WriteString(" " + key + " {" + r.render(v.pattern, depth+1) + "}")
+	}
+	if !hasOther && def != nil {
+		b.WriteString(" other {" + r.render(def.pattern, depth+1) + "}")
+	}
+	b.WriteString("}")
+	return b.String()
+}
+
+// isPluralCategory
+func isPluralCategory(s string) bool {
+	for _, category := range cldrCategoryOrder {
+		if s == category {
+			return true
+		}
+	}
+	return false
+}
+
+// escapeICU escapes the characters of a literal text that are special in ICU MessageFormat.
+func escapeICU(s string) string {
+	if !strings.ContainsAny(s, "{}#") {
+		return s
+	}
+	return strings.NewReplacer("{", `\{`, "}", `\}`, "#", `\#`).Replace(s)
+}
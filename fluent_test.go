@@ -0,0 +1,53 @@
+package i18n
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnmarshalFTL(t *testing.T) {
+	assert := assert.New(t)
+
+	b, err := os.ReadFile("test/fr.ftl")
+	assert.NoError(err)
+	var messages map[string]string
+	assert.NoError(UnmarshalFTL(b, &messages))
+	assert.Equal(map[string]string{
+		"hello":             "Bonjour, {name} !",
+		"about":             "À propos de Firefox",
+		"brand-update":      "Le Firefox est à jour.",
+		"emails":            "{count, plural, =0 {Aucun e-mail} one {{count} e-mail} other {{count} e-mails}}",
+		"role":              "{role, select, admin {Administrateur} user {Utilisateur} other {Utilisateur}}",
+		"login":             "Connexion",
+		"login.placeholder": "Adresse e-mail",
+		"login.title":       "Se connecter à À propos de Firefox",
+		"multiline":         "Première ligne\nDeuxième ligne avec \\{accolades\\} et \\#",
+		"welcome":           "Bienvenue, Connexion",
+	}, messages)
+
+	bundle := NewBundle(
+		WithDefaultLocale("fr"),
+		WithUnmarshaler(UnmarshalFTL),
+	)
+	assert.NoError(bundle.LoadFiles("test/fr.ftl"))
+	localizer := bundle.NewLocalizer("fr")
+	assert.Equal("Bonjour, Yami !", localizer.Get("hello", Vars{"name": "Yami"}))
+	assert.Equal("Aucun e-mail", localizer.Get("emails", Vars{"count": 0}))
+	assert.Equal("1 e-mail", localizer.Get("emails", Vars{"count": 1}))
+	assert.Equal("3 e-mails", localizer.Get("emails", Vars{"count": 3}))
+	assert.Equal("Administrateur", localizer.Get("role", Vars{"role": "admin"}))
+	assert.Equal("Utilisateur", localizer.Get("role", Vars{"role": "guest"}))
+	assert.Equal("Première ligne\nDeuxième ligne avec {accolades} et #", localizer.Get("multiline", Vars{}))
+
+	for _, data := range []string{
+		"  indented = text",
+		"no value",
+		"broken = { $name",
+		"broken = { $count ->\n  [one] one\n",
+	} {
+		assert.True(errors.Is(UnmarshalFTL([]byte(data), &messages), ErrInvalidFluent), data)
+	}
+}
@@ -0,0 +1,42 @@
+package i18n
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+
+	"github.com/goccy/go-json"
+	"github.com/pelletier/go-toml/v2"
+	"gopkg.in/yaml.v3"
+)
+
+// unmarshalers are the built-in unmarshalers of the file extensions, used if `WithUnmarshaler` isn't set.
+var unmarshalers = map[string]Unmarshaler{
+	".json":        json.Unmarshal,
+	".yaml":        yaml.Unmarshal,
+	".yml":         yaml.Unmarshal,
+	".toml":        toml.Unmarshal,
+	".po":          UnmarshalPO,
+	".mo":          UnmarshalMO,
+	".ftl":         UnmarshalFTL,
+	".strings":     UnmarshalStrings,
+	".stringsdict": UnmarshalStringsdict,
+}
+
+// detectUnmarshaler returns the unmarshaler of a file by its extension,
+// or by sniffing its content between JSON, TOML and YAML if the extension is unknown.
+func detectUnmarshaler(file string, data []byte) Unmarshaler {
+	if u, ok := unmarshalers[strings.ToLower(filepath.Ext(file))]; ok {
+		return u
+	}
+
+	trimmed := bytes.TrimSpace(bytes.TrimPrefix(data, []byte("\ufeff")))
+	if len(trimmed) > 0 && trimmed[0] == '{' {
+		return json.Unmarshal
+	}
+	var v map[string]any
+	if safeUnmarshal(toml.Unmarshal, data, &v) == nil {
+		return toml.Unmarshal
+	}
+	return yaml.Unmarshal
+}
@@ -0,0 +1,33 @@
+package i18n
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetectUnmarshaler(t *testing.T) {
+	assert := assert.New(t)
+
+	bundle := NewBundle(
+		WithDefaultLocale("zh-Hans"),
+		WithLocales("zh-Hans", "ru"),
+	)
+	assert.NoError(bundle.LoadFiles("test/zh-Hans.yml", "test/ru.po"))
+	assert.Equal("讯息 A", bundle.NewLocalizer("zh-Hans").Get("message_a"))
+	assert.Equal("3 файла", bundle.NewLocalizer("ru").Get("files", Vars{"count": 3}))
+
+	bundle = NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "zh-Hans", "fr"),
+	)
+	assert.NoError(bundle.LoadFS(fstest.MapFS{
+		"en.txt":      {Data: []byte("\n{\"hello\": \"Hello\"}")},
+		"zh-Hans.txt": {Data: []byte("hello = \"你好\"")},
+		"fr.txt":      {Data: []byte("hello: Bonjour")},
+	}, "*.txt"))
+	assert.Equal("Hello", bundle.NewLocalizer("en").Get("hello"))
+	assert.Equal("你好", bundle.NewLocalizer("zh-Hans").Get("hello"))
+	assert.Equal("Bonjour", bundle.NewLocalizer("fr").Get("hello"))
+}
@@ -0,0 +1,94 @@
+package i18n
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/gotnospirit/messageformat"
+	"golang.org/x/text/language"
+)
+
+// styledArgument is an argument of a message with a type and an optional style, like `{when, date, long}`.
+type styledArgument struct {
+	name  string
+	style string
+}
+
+// argumentFormatter formats the value of a styled argument in a locale.
+type argumentFormatter func(tag language.Tag, value any, style string) (string, error)
+
+// argumentFormatters are the argument types added to the ones of MessageFormat (`plural`, `select`...).
+var argumentFormatters = map[string]argumentFormatter{
+	"date": func(tag language.Tag, value any, style string) (string, error) {
+		t, ok := dateValue(value)
+		if !ok {
+			return "", fmt.Errorf("invalid date: %T", value)
+		}
+		tag = dateArgumentTag(tag, value)
+		return formatDatePattern(tag, t, dateStylePattern(tag, style)), nil
+	},
+	"time": func(tag language.Tag, value any, style string) (string, error) {
+		t, ok := dateValue(value)
+		if !ok {
+			return "", fmt.Errorf("invalid time: %T", value)
+		}
+		tag = dateArgumentTag(tag, value)
+		return formatDatePattern(tag, t, datePattern(lookupLocaleData(tag).timeFormats, style)), nil
+	},
+	"list":   formatListArgument,
+	"number": formatNumberArgument,
+	// The numbers are spelled out in `en`, `de`, `es`, `fr`, `ja`, `zh` and `zh-Hant`, with digits otherwise.
+	"spellout": formatSpellOutArgument,
+}
+
+// newMessageParser returns a MessageFormat parser of the locale with the argument types of `argumentFormatters`.
+func newMessageParser(tag language.Tag) (*messageformat.Parser, error) {
+	base, _ := tag.Base()
+	parser, err := messageformat.NewWithCulture(base.String())
+	if err != nil {
+		// The plural function is set on each message, the culture of the parser is only a default.
+		if parser, err = messageformat.New(); err != nil {
+			return nil, err
+		}
+	}
+	for typ, format := range argumentFormatters {
+		if err := parser.Register(typ, parseStyledArgument, formatStyledArgument(tag, format)); err != nil {
+			return nil, err
+		}
+	}
+	return parser, nil
+}
+
+// parseStyledArgument parses the optional style of an argument after its type, until the closing brace.
+func parseStyledArgument(name string, _ *messageformat.Parser, char rune, start, end int, input *[]rune) (messageformat.Expression, int, error) {
+	arg := styledArgument{name: name}
+	if char == messageformat.CloseChar {
+		return arg, start, nil
+	}
+	pos := start + 1
+	for pos < end && (*input)[pos] != messageformat.CloseChar {
+		if (*input)[pos] == messageformat.OpenChar {
+			return nil, pos, fmt.Errorf("InvalidStyle")
+		}
+		pos++
+	}
+	if pos >= end {
+		return nil, pos, fmt.Errorf("UnbalancedBraces")
+	}
+	arg.style = strings.TrimSpace(string((*input)[start+1 : pos]))
+	return arg, pos, nil
+}
+
+// formatStyledArgument returns the MessageFormat formatter of an argument type in the locale.
+func formatStyledArgument(tag language.Tag, format argumentFormatter) func(messageformat.Expression, *bytes.Buffer, *map[string]interface{}, *messageformat.MessageFormat, string) error {
+	return func(expr messageformat.Expression, output *bytes.Buffer, data *map[string]interface{}, _ *messageformat.MessageFormat, _ string) error {
+		arg := expr.(styledArgument)
+		s, err := format(tag, (*data)[arg.name], arg.style)
+		if err != nil {
+			return fmt.Errorf("%s: %w", arg.name, err)
+		}
+		output.WriteString(s)
+		return nil
+	}
+}
@@ -0,0 +1,36 @@
+package i18n
+
+import "errors"
+
+// ErrFrozen is returned when a frozen bundle is modified, see `Freeze`.
+var ErrFrozen = errors.New("i18n: bundle is frozen")
+
+// Freeze returns a read-only copy of the bundle with the same options and catalogs, for the servers that treat
+// the catalogs as fixed once loaded. Its lookups take no lock and never write the runtime parse cache (the names
+// already cached are kept), and the methods that modify it fail with `ErrFrozen`. The bundle itself is unchanged.
+// Call `Warmup` before freezing to compile the messages ahead of the first requests.
+func (bundle *I18n) Freeze() *I18n {
+	frozen := bundle.Snapshot()
+
+	bundle.mu.RLock()
+	defer bundle.mu.RUnlock()
+
+	frozen.runtimeParsedTranslations = bundle.runtimeParsedTranslations.copy()
+	if len(bundle.tenants) > 0 {
+		frozen.tenants = make(map[string]map[string]map[string]*parsedTranslation, len(bundle.tenants))
+		for tenant, overrides := range bundle.tenants {
+			copied := make(map[string]map[string]*parsedTranslation, len(overrides))
+			for locale, translations := range overrides {
+				copied[locale] = translations
+			}
+			frozen.tenants[tenant] = copied
+		}
+	}
+	frozen.frozen = true
+	return frozen
+}
+
+// Frozen indicates whether the bundle is read-only, see `Freeze`.
+func (bundle *I18n) Frozen() bool {
+	return bundle.frozen
+}
@@ -0,0 +1,53 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFreeze(t *testing.T) {
+	assert := assert.New(t)
+
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "zh-Hans"),
+	)
+	assert.NoError(bundle.LoadMessages(map[string]map[string]string{
+		"en":      {"hello": "Hello", "bye": "Bye"},
+		"zh-Hans": {"hello": "你好"},
+	}))
+	assert.NoError(bundle.SetTenantOverrides("acme", map[string]map[string]string{
+		"en": {"hello": "Howdy"},
+	}))
+	assert.Equal("Cached", bundle.NewLocalizer("en").Get("Cached"))
+
+	frozen := bundle.Freeze()
+	assert.True(frozen.Frozen())
+	assert.False(bundle.Frozen())
+
+	localizer := frozen.NewLocalizer("zh-Hans")
+	assert.Equal("你好", localizer.Get("hello"))
+	assert.Equal("Bye", localizer.Get("bye"))
+	assert.Equal("Howdy", frozen.NewLocalizer("en").ForTenant("acme").Get("hello"))
+	assert.Equal("Unknown {n}", localizer.Get("Unknown {n}"))
+	assert.Contains(frozen.runtimeParsedTranslations.items, "Cached")
+	assert.NotContains(frozen.runtimeParsedTranslations.items, "Unknown {n}")
+
+	assert.ErrorIs(frozen.LoadMessages(map[string]map[string]string{"en": {"hello": "Hi"}}), ErrFrozen)
+	assert.ErrorIs(frozen.AddMessages("en", map[string]string{"hello": "Hi"}), ErrFrozen)
+	assert.ErrorIs(frozen.UnloadMessages("en"), ErrFrozen)
+	assert.ErrorIs(frozen.RegisterLocale("fr"), ErrFrozen)
+	assert.ErrorIs(frozen.RemoveLocale("zh-Hans"), ErrFrozen)
+	assert.ErrorIs(frozen.SetTenantOverrides("acme", nil), ErrFrozen)
+	assert.ErrorIs(frozen.LoadFiles("test/zh-Hans.json"), ErrFrozen)
+	assert.ErrorIs(frozen.Swap(bundle), ErrFrozen)
+	assert.ErrorIs(frozen.RemoveTenantOverrides("acme"), ErrFrozen)
+	assert.Equal("Hello", frozen.NewLocalizer("en").Get("hello"))
+
+	// The bundle itself can still change, the frozen copy doesn't see it.
+	assert.NoError(bundle.AddMessages("en", map[string]string{"hello": "Hi"}))
+	assert.Equal("Hi", bundle.NewLocalizer("en").Get("hello"))
+	assert.Equal("Hello", frozen.NewLocalizer("en").Get("hello"))
+	assert.False(frozen.Snapshot().Frozen())
+}
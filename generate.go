@@ -0,0 +1,442 @@
+package i18n
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/format"
+	"io"
+	"io/fs"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/language"
+)
+
+// ErrIdentifierConflict is returned by `GenerateGo` when two names convert to the same Go identifier.
+var ErrIdentifierConflict = errors.New("i18n: conflicting generated identifiers")
+
+// GenerateGo writes the Go source of a package that compiles the translations of the files matching the patterns
+// (read like `LoadFS`) to Go, for `go:generate`, see the `i18n-gen` command. Each message with arguments becomes a
+// `MessageFunc` of `Funcs` which switches on its plural categories and select values, so no message is parsed at
+// runtime and a broken message fails the generation. A `Msg` constant is declared for each name, like
+// `MsgErrorsAuthInvalid` for `errors.auth.invalid`, so the unknown names fail to compile. The package declares
+// the texts of the `Messages` and `Load(bundle)` which loads them with their functions, see `LoadGenerated`.
+func GenerateGo(w io.Writer, pkg string, fsys fs.FS, patterns ...string) error {
+	data, err := readFS(fsys, patterns, nil)
+	if err != nil {
+		return err
+	}
+
+	locales := make([]string, 0, len(data))
+	names := make(map[string]string)
+	nodes := make(map[string]map[string][]messageNode, len(data))
+	for locale, messages := range data {
+		locales = append(locales, locale)
+		nodes[locale] = make(map[string][]messageNode)
+		for name, text := range messages {
+			names[name] = goIdentifier(name)
+			if isStaticMessage(text) {
+				continue
+			}
+			if err := checkMessage(text); err != nil {
+				return fmt.Errorf("%w: %s %q: %v", ErrInvalidMessage, locale, name, err)
+			}
+			if nodes[locale][name], err = parseMessageNodes(text); err != nil {
+				return fmt.Errorf("%w: %s %q: %v", ErrInvalidMessage, locale, name, err)
+			}
+		}
+	}
+	slices.Sort(locales)
+	sortedNames := make([]string, 0, len(names))
+	for name := range names {
+		sortedNames = append(sortedNames, name)
+	}
+	slices.Sort(sortedNames)
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by i18n-gen. DO NOT EDIT.\n\npackage %s\n\n", pkg)
+	buf.WriteString("import \"github.com/kaptinlin/go-i18n\"\n\n")
+	buf.WriteString("// The names of the messages.\nconst (\n")
+	identifiers := make(map[string]string, len(names))
+	for _, name := range sortedNames {
+		identifier := names[name]
+		if other, ok := identifiers[identifier]; ok {
+			return fmt.Errorf("%w: %q and %q are %s", ErrIdentifierConflict, other, name, identifier)
+		}
+		identifiers[identifier] = name
+		fmt.Fprintf(&buf, "\t%s = %s\n", identifier, strconv.Quote(name))
+	}
+	buf.WriteString(")\n\n")
+	buf.WriteString("// Messages are the texts of the messages by locale.\nvar Messages = map[string]map[string]string{\n")
+	for _, locale := range locales {
+		fmt.Fprintf(&buf, "\t%s: {\n", strconv.Quote(locale))
+		for _, name := range sortedKeys(data[locale]) {
+			fmt.Fprintf(&buf, "\t\t%s: %s,\n", names[name], strconv.Quote(data[locale][name]))
+		}
+		buf.WriteString("\t},\n")
+	}
+	buf.WriteString("}\n\n")
+	buf.WriteString("// Funcs format the messages with arguments by locale, the other messages are plain text.\n")
+	buf.WriteString("var Funcs = map[string]map[string]i18n.MessageFunc{\n")
+	for _, locale := range locales {
+		if len(nodes[locale]) == 0 {
+			continue
+		}
+		fmt.Fprintf(&buf, "\t%s: {\n", strconv.Quote(locale))
+		for _, name := range sortedKeys(nodes[locale]) {
+			fmt.Fprintf(&buf, "\t\t%s: func(w *i18n.MessageWriter, vars i18n.Vars) {\n", names[name])
+			writeMessageNodes(&buf, nodes[locale][name], false)
+			buf.WriteString("\t\t},\n")
+		}
+		buf.WriteString("\t},\n")
+	}
+	buf.WriteString("}\n\n")
+	buf.WriteString("// Load loads the messages into the bundle.\nfunc Load(bundle *i18n.I18n) error {\n\treturn bundle.LoadGenerated(Messages, Funcs)\n}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(src)
+	return err
+}
+
+// checkMessage compiles a message to check it.
+func checkMessage(text string) error {
+	parser, err := newMessageParser(language.English)
+	if err != nil {
+		return err
+	}
+	_, err = parser.Parse(text)
+	return err
+}
+
+// sortedKeys returns the keys of a map in order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// messageNode is a part of a message compiled by `GenerateGo`: a literal if it has no name, or an argument
+// with its choices.
+type messageNode struct {
+	// literal are the parts of a literal, the empty ones are `#`.
+	literal []string
+	name    string
+	// typ is the type of the argument, empty for a simple argument like `{name}`.
+	typ    string
+	style  string
+	offset int
+	// keys are the keys of the choices in order, without duplicates, the choices of the duplicated keys are the last ones.
+	keys    []string
+	choices map[string][]messageNode
+}
+
+// parseMessageNodes parses a message checked by `checkMessage` with the syntax of MessageFormat: a backslash escapes
+// the braces and `#`, the whitespaces are ignored around the names, the types and the keys.
+func parseMessageNodes(text string) ([]messageNode, error) {
+	p := &messageNodeParser{input: []rune(text)}
+	nodes, err := p.parseText()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.input) {
+		return nil, fmt.Errorf("unbalanced braces at %d", p.pos)
+	}
+	return nodes, nil
+}
+
+// messageNodeParser
+type messageNodeParser struct {
+	input []rune
+	pos   int
+}
+
+// parseText parses a text until its end, or until the closing brace of a choice which isn't consumed.
+func (p *messageNodeParser) parseText() ([]messageNode, error) {
+	var nodes []messageNode
+	start := p.pos
+	escaped := false
+	for p.pos < len(p.input) {
+		switch r := p.input[p.pos]; {
+		case r == '\\':
+			escaped = true
+			p.pos++
+		case r == '}' && !escaped:
+			if p.pos > start {
+				nodes = append(nodes, messageNode{literal: splitLiteral(p.input[start:p.pos])})
+			}
+			return nodes, nil
+		case r == '{' && !escaped:
+			if p.pos > start {
+				nodes = append(nodes, messageNode{literal: splitLiteral(p.input[start:p.pos])})
+			}
+			p.pos++
+			node, err := p.parseArgument()
+			if err != nil {
+				return nil, err
+			}
+			nodes = append(nodes, node)
+			start = p.pos
+		default:
+			escaped = false
+			p.pos++
+		}
+	}
+	if p.pos > start {
+		nodes = append(nodes, messageNode{literal: splitLiteral(p.input[start:p.pos])})
+	}
+	return nodes, nil
+}
+
+// parseArgument parses an argument after its opening brace, until its closing brace which is consumed.
+func (p *messageNodeParser) parseArgument() (messageNode, error) {
+	var node messageNode
+	var end rune
+	node.name, end = p.readUntil(",}")
+	if end == '}' {
+		return node, nil
+	}
+	node.typ, end = p.readUntil(",}")
+	switch {
+	case end == '}':
+		return node, nil
+	case node.typ != "plural" && node.typ != "selectordinal" && node.typ != "select":
+		node.style, end = p.readUntil("}")
+		if end != '}' {
+			return node, fmt.Errorf("unbalanced braces in %q", node.name)
+		}
+		return node, nil
+	}
+
+	node.choices = make(map[string][]messageNode)
+	for {
+		p.skipSpaces()
+		if p.pos >= len(p.input) {
+			return node, fmt.Errorf("unbalanced braces in %q", node.name)
+		}
+		if p.input[p.pos] == '}' {
+			p.pos++
+			return node, nil
+		}
+		key := p.readKey()
+		if key == "offset" && p.pos < len(p.input) && p.input[p.pos] == ':' {
+			p.pos++
+			p.skipSpaces()
+			offset, err := strconv.Atoi(p.readKey())
+			if err != nil {
+				return node, fmt.Errorf("invalid offset in %q", node.name)
+			}
+			node.offset = offset
+			continue
+		}
+		p.skipSpaces()
+		if key == "" || p.pos >= len(p.input) || p.input[p.pos] != '{' {
+			return node, fmt.Errorf("missing choice in %q", node.name)
+		}
+		p.pos++
+		choice, err := p.parseText()
+		if err != nil {
+			return node, err
+		}
+		if p.pos >= len(p.input) {
+			return node, fmt.Errorf("unbalanced braces in %q", node.name)
+		}
+		p.pos++
+		if _, ok := node.choices[key]; !ok {
+			node.keys = append(node.keys, key)
+		}
+		node.choices[key] = choice
+	}
+}
+
+// readUntil reads a trimmed token until one of the delimiters, which is consumed and returned.
+func (p *messageNodeParser) readUntil(delims string) (string, rune) {
+	start := p.pos
+	for p.pos < len(p.input) {
+		r := p.input[p.pos]
+		p.pos++
+		if strings.ContainsRune(delims, r) {
+			return strings.TrimSpace(string(p.input[start : p.pos-1])), r
+		}
+	}
+	return strings.TrimSpace(string(p.input[start:])), 0
+}
+
+// readKey reads a choice key like `=0` or `other`, until a whitespace, a brace, a comma or a colon.
+func (p *messageNodeParser) readKey() string {
+	start := p.pos
+	for p.pos < len(p.input) && !strings.ContainsRune(" \t\r\n{},:", p.input[p.pos]) {
+		p.pos++
+	}
+	return string(p.input[start:p.pos])
+}
+
+// skipSpaces skips the whitespaces of MessageFormat.
+func (p *messageNodeParser) skipSpaces() {
+	for p.pos < len(p.input) && strings.ContainsRune(" \t\r\n", p.input[p.pos]) {
+		p.pos++
+	}
+}
+
+// splitLiteral splits a literal at its `#` like MessageFormat does, the `#` are empty parts. The backslash before
+// an escaped brace or `#` is removed, the other backslashes are kept.
+func splitLiteral(input []rune) []string {
+	var items []int
+	escaped := false
+	s, e := 0, 0
+	gap := 0
+	for i, c := range input {
+		if c == '\\' {
+			gap++
+			e++
+			escaped = true
+			continue
+		}
+		switch c {
+		default:
+			e++
+		case '{', '}', '#':
+			if escaped {
+				if i-s > gap {
+					if gap > 1 {
+						items = append(items, s, i)
+					} else {
+						items = append(items, s, i-1)
+					}
+				}
+				s = i
+			} else {
+				if s != e {
+					items = append(items, s, e, i, i)
+				} else if s != i {
+					items = append(items, s, i, i, i)
+				} else {
+					items = append(items, i, i)
+				}
+				s = i + 1
+			}
+			e = s
+		}
+		escaped = false
+		gap = 0
+	}
+	if s < len(input) {
+		items = append(items, s, len(input))
+	}
+
+	parts := make([]string, len(items)/2)
+	for i := 0; i < len(items); i += 2 {
+		parts[i/2] = string(input[items[i]:items[i+1]])
+	}
+	return parts
+}
+
+// writeMessageNodes writes the Go statements of the nodes to the body of a `MessageFunc`, inChoice indicates
+// whether `pound` holds the value of `#`.
+func writeMessageNodes(buf *bytes.Buffer, nodes []messageNode, inChoice bool) {
+	for _, node := range nodes {
+		switch {
+		case node.name == "":
+			var text strings.Builder
+			for _, part := range node.literal {
+				if part != "" {
+					text.WriteString(part)
+					continue
+				}
+				if !inChoice {
+					text.WriteString("#")
+					continue
+				}
+				if text.Len() > 0 {
+					fmt.Fprintf(buf, "w.WriteString(%s)\n", strconv.Quote(text.String()))
+					text.Reset()
+				}
+				buf.WriteString("w.WritePound(pound)\n")
+			}
+			if text.Len() > 0 {
+				fmt.Fprintf(buf, "w.WriteString(%s)\n", strconv.Quote(text.String()))
+			}
+		case node.typ == "":
+			fmt.Fprintf(buf, "w.WriteArg(vars, %s)\n", strconv.Quote(node.name))
+		case node.choices != nil:
+			writeChoiceNode(buf, node)
+		default:
+			fmt.Fprintf(buf, "w.WriteFormatted(vars, %s, %s, %s)\n",
+				strconv.Quote(node.name), strconv.Quote(node.typ), strconv.Quote(node.style))
+		}
+	}
+}
+
+// writeChoiceNode writes the switch on the choices of a plural, selectordinal or select argument.
+func writeChoiceNode(buf *bytes.Buffer, node messageNode) {
+	var keys []string
+	pound := "_"
+	for _, key := range node.keys {
+		if key != "other" {
+			keys = append(keys, strconv.Quote(key))
+		}
+		if usesPound(node.choices[key]) {
+			pound = "pound"
+		}
+	}
+	args := []string{"vars", strconv.Quote(node.name)}
+	switch node.typ {
+	case "plural":
+		args = append(args, strconv.Itoa(node.offset))
+		node.typ = "Plural"
+	case "selectordinal":
+		node.typ = "Ordinal"
+	default:
+		node.typ = "Select"
+	}
+	args = append(args, keys...)
+
+	fmt.Fprintf(buf, "switch key, %s := w.%s(%s); key {\n", pound, node.typ, strings.Join(args, ", "))
+	for _, key := range node.keys {
+		if key == "other" {
+			continue
+		}
+		fmt.Fprintf(buf, "case %s:\n", strconv.Quote(key))
+		writeMessageNodes(buf, node.choices[key], true)
+	}
+	buf.WriteString("default:\n")
+	writeMessageNodes(buf, node.choices["other"], true)
+	buf.WriteString("}\n")
+}
+
+// usesPound indicates whether a choice writes `#` outside of its nested choices.
+func usesPound(nodes []messageNode) bool {
+	for _, node := range nodes {
+		if slices.Contains(node.literal, "") {
+			return true
+		}
+	}
+	return false
+}
+
+// goIdentifier converts a name to an exported Go identifier, `errors.auth.invalid` to `MsgErrorsAuthInvalid`.
+func goIdentifier(name string) string {
+	var b strings.Builder
+	b.WriteString("Msg")
+	upper := true
+	for _, r := range name {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
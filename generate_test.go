@@ -0,0 +1,106 @@
+package i18n
+
+import (
+	"bytes"
+	"go/parser"
+	"go/token"
+	"os"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateGo(t *testing.T) {
+	assert := assert.New(t)
+
+	fsys := fstest.MapFS{
+		"locales/en.json":      {Data: []byte(`{"hello": "Hello, {name}", "errors": {"auth": {"invalid": "Invalid \"token\""}}}`)},
+		"locales/zh-Hans.json": {Data: []byte(`{"hello": "你好, {name}"}`)},
+	}
+	var buf bytes.Buffer
+	assert.NoError(GenerateGo(&buf, "locales", fsys, "locales/*.json"))
+
+	src := buf.String()
+	assert.Contains(src, "// Code generated by i18n-gen. DO NOT EDIT.")
+	assert.Contains(src, `MsgErrorsAuthInvalid = "errors.auth.invalid"`)
+	assert.Contains(src, `MsgHello             = "hello"`)
+	assert.Contains(src, `MsgErrorsAuthInvalid: "Invalid \"token\"",`)
+	assert.Contains(src, `"zh-hans": {`)
+	assert.Contains(src, `MsgHello: func(w *i18n.MessageWriter, vars i18n.Vars) {`)
+	assert.Contains(src, `w.WriteArg(vars, "name")`)
+	assert.Contains(src, `return bundle.LoadGenerated(Messages, Funcs)`)
+	_, err := parser.ParseFile(token.NewFileSet(), "messages_gen.go", src, 0)
+	assert.NoError(err)
+
+	broken := fstest.MapFS{"en.json": {Data: []byte(`{"files": "{count, plural, one {#}"}`)}}
+	assert.ErrorIs(GenerateGo(&buf, "locales", broken, "*.json"), ErrInvalidMessage)
+
+	conflict := fstest.MapFS{"en.json": {Data: []byte(`{"sign_in": "Sign in", "sign.in": "Sign in"}`)}}
+	assert.ErrorIs(GenerateGo(&buf, "locales", conflict, "*.json"), ErrIdentifierConflict)
+}
+
+func TestGenerateGoExample(t *testing.T) {
+	assert := assert.New(t)
+
+	// The generated example is tested against the parsed messages in its package, it must be up to date.
+	want, err := os.ReadFile("examples/generate/locales/messages_gen.go")
+	assert.NoError(err)
+	var buf bytes.Buffer
+	assert.NoError(GenerateGo(&buf, "locales", os.DirFS("examples/generate/locales"), "*.json"))
+	assert.Equal(string(want), buf.String())
+}
+
+func TestParseMessageNodes(t *testing.T) {
+	assert := assert.New(t)
+
+	nodes, err := parseMessageNodes(`\{a\} {n, plural, offset: 1 =0 {none} other {# {x}}}{when, date, long}`)
+	assert.NoError(err)
+	assert.Equal([]messageNode{
+		{literal: []string{"{a", "} "}},
+		{name: "n", typ: "plural", offset: 1, keys: []string{"=0", "other"}, choices: map[string][]messageNode{
+			"=0":    {{literal: []string{"none"}}},
+			"other": {{literal: []string{"", " "}}, {name: "x"}},
+		}},
+		{name: "when", typ: "date", style: "long"},
+	}, nodes)
+
+	_, err = parseMessageNodes("{n, select, a {x}")
+	assert.Error(err)
+}
+
+func TestLoadGenerated(t *testing.T) {
+	assert := assert.New(t)
+
+	bundle := NewBundle(WithDefaultLocale("en"), WithLocales("en"))
+	// The text isn't valid, the message is never parsed.
+	assert.NoError(bundle.LoadGenerated(map[string]map[string]string{
+		"en": {"files": "{count, plural"},
+	}, map[string]map[string]MessageFunc{
+		"en": {"files": func(w *MessageWriter, vars Vars) {
+			switch key, pound := w.Plural(vars, "count", 0, "one"); key {
+			case "one":
+				w.WriteString("one file")
+			default:
+				w.WritePound(pound)
+				w.WriteString(" files")
+			}
+		}},
+	}))
+	localizer := bundle.NewLocalizer("en")
+	assert.Equal("one file", localizer.Get("files", Vars{"count": 1}))
+	assert.Equal("3 files", localizer.Get("files", Vars{"count": 3}))
+	assert.NoError(bundle.Warmup(nil, nil))
+
+	_, err := localizer.GetE("files", Vars{"count": []int{}})
+	assert.ErrorIs(err, ErrFormatMessage)
+}
+
+func TestGoIdentifier(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("MsgHello", goIdentifier("hello"))
+	assert.Equal("MsgErrorsAuthInvalid", goIdentifier("errors.auth.invalid"))
+	assert.Equal("MsgPostVerb", goIdentifier("Post <verb>"))
+	assert.Equal("Msg404NotFound", goIdentifier("404_not-found"))
+}
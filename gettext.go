@@ -0,0 +1,598 @@
+package i18n
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ErrInvalidGettext is returned when a PO or MO file cannot be parsed.
+var ErrInvalidGettext = errors.New("i18n: invalid gettext file")
+
+// gettextMessage is an entry of a PO or MO file.
+type gettextMessage struct {
+	context  string
+	id       string
+	idPlural string
+	strs     []string
+	fuzzy    bool
+}
+
+// UnmarshalPO is an `Unmarshaler` for the gettext PO files, to be used with `WithUnmarshaler`.
+//
+// The `msgctxt` of an entry is mapped to the `GetX` context like `msgid <msgctxt>`. The plural entries are
+// converted to an ICU `plural` argument named `count`: the forms of the `Plural-Forms` header are mapped to
+// the CLDR categories of the `Language` header, and `%d` is replaced by `#`. The fuzzy and the untranslated
+// entries are skipped, so the fallbacks apply.
+func UnmarshalPO(data []byte, v any) error {
+	messages, err := parsePO(data)
+	if err != nil {
+		return err
+	}
+	return unmarshalGettext(messages, v)
+}
+
+// UnmarshalMO is an `Unmarshaler` for the gettext MO files, see `UnmarshalPO`.
+func UnmarshalMO(data []byte, v any) error {
+	messages, err := parseMO(data)
+	if err != nil {
+		return err
+	}
+	return unmarshalGettext(messages, v)
+}
+
+// ExportPO writes the translations of a locale as a gettext PO file. The messages of the default locale
+// that are not translated in the locale are written with an empty `msgstr`, so the file can be handed to
+// the translators, and the contexts are written as `msgctxt`.
+func (bundle *I18n) ExportPO(w io.Writer, locale string) error {
+	bundle.mu.RLock()
+	supported := bundle.getExactSupportedLocale(locale)
+	if supported == "" {
+		bundle.mu.RUnlock()
+		return fmt.Errorf("%w: %q", ErrInvalidLocale, locale)
+	}
+	locale = supported
+	messages := make(map[string]string)
+	for name := range bundle.parsedTranslations[bundle.defaultLocale] {
+		messages[name] = ""
+	}
+	for name, trans := range bundle.parsedTranslations[locale] {
+		if trans.locale == locale {
+			messages[name] = trans.text
+		} else {
+			messages[name] = ""
+		}
+	}
+	bundle.mu.RUnlock()
+
+	names := make([]string, 0, len(messages))
+	for name := range messages {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	b := bufio.NewWriter(w)
+	fmt.Fprintf(b, "msgid \"\"\nmsgstr \"\"\n\"Language: %s\\n\"\n\"MIME-Version: 1.0\\n\"\n\"Content-Type: text/plain; charset=UTF-8\\n\"\n\"Content-Transfer-Encoding: 8bit\\n\"\n", locale)
+	for _, name := range names {
+		id, ctx := splitContext(name)
+		b.WriteString("\n")
+		if ctx != "" {
+			fmt.Fprintf(b, "msgctxt %s\n", quotePO(ctx))
+		}
+		fmt.Fprintf(b, "msgid %s\nmsgstr %s\n", quotePO(id), quotePO(messages[name]))
+	}
+	return b.Flush()
+}
+
+// unmarshalGettext converts the gettext entries to the messages.
+func unmarshalGettext(messages []gettextMessage, v any) error {
+	out, ok := v.(*map[string]string)
+	if !ok {
+		return fmt.Errorf("%w: unsupported target %T", ErrInvalidGettext, v)
+	}
+	if *out == nil {
+		*out = make(map[string]string)
+	}
+
+	var header map[string]string
+	for _, m := range messages {
+		if m.id == "" && m.context == "" && len(m.strs) > 0 {
+			header = parseGettextHeader(m.strs[0])
+		}
+	}
+
+	var forms *gettextPluralForms
+	for _, m := range messages {
+		if m.id == "" || m.fuzzy || len(m.strs) == 0 {
+			continue
+		}
+		name := m.id
+		if m.context != "" {
+			name = withContext(m.id, m.context)
+		}
+		if m.idPlural == "" {
+			if m.strs[0] != "" {
+				(*out)[name] = m.strs[0]
+			}
+			continue
+		}
+
+		if forms == nil {
+			var err error
+			if forms, err = newGettextPluralForms(header["Plural-Forms"], header["Language"]); err != nil {
+				return err
+			}
+		}
+		text, ok := forms.icu(m.strs)
+		if ok {
+			(*out)[name] = text
+		}
+	}
+	return nil
+}
+
+// parseGettextHeader parses the `Key: Value` lines of the header entry.
+func parseGettextHeader(s string) map[string]string {
+	header := make(map[string]string)
+	for _, line := range strings.Split(s, "\n") {
+		if k, v, ok := strings.Cut(line, ":"); ok {
+			header[strings.TrimSpace(k)] = strings.TrimSpace(v)
+		}
+	}
+	return header
+}
+
+// parsePO parses the entries of a PO file.
+func parsePO(data []byte) ([]gettextMessage, error) {
+	var messages []gettextMessage
+	var m gettextMessage
+	var target *string
+
+	// An entry is complete once it has a `msgstr`, the next comment or keyword starts a new one.
+	flush := func() {
+		if len(m.strs) > 0 {
+			messages = append(messages, m)
+			m, target = gettextMessage{}, nil
+		}
+	}
+
+	for i, line := range strings.Split(string(bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))), "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case line == "":
+			continue
+		case strings.HasPrefix(line, "#"):
+			flush()
+			if strings.HasPrefix(line, "#,") && strings.Contains(line, "fuzzy") {
+				m.fuzzy = true
+			}
+			continue
+		case strings.HasPrefix(line, `"`):
+			if target == nil {
+				return nil, fmt.Errorf("%w: unexpected string at line %d", ErrInvalidGettext, i+1)
+			}
+			s, err := unquotePO(line)
+			if err != nil {
+				return nil, fmt.Errorf("%w: line %d: %v", ErrInvalidGettext, i+1, err)
+			}
+			*target += s
+			continue
+		}
+
+		keyword, value, _ := strings.Cut(line, " ")
+		s, err := unquotePO(strings.TrimSpace(value))
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: %v", ErrInvalidGettext, i+1, err)
+		}
+		switch {
+		case keyword == "msgctxt":
+			flush()
+			m.context = s
+			target = &m.context
+		case keyword == "msgid":
+			flush()
+			m.id = s
+			target = &m.id
+		case keyword == "msgid_plural":
+			m.idPlural = s
+			target = &m.idPlural
+		case keyword == "msgstr":
+			m.strs = []string{s}
+			target = &m.strs[0]
+		case strings.HasPrefix(keyword, "msgstr[") && strings.HasSuffix(keyword, "]"):
+			n, err := strconv.Atoi(keyword[len("msgstr[") : len(keyword)-1])
+			if err != nil || n != len(m.strs) {
+				return nil, fmt.Errorf("%w: unexpected %s at line %d", ErrInvalidGettext, keyword, i+1)
+			}
+			m.strs = append(m.strs, s)
+			target = &m.strs[n]
+		default:
+			return nil, fmt.Errorf("%w: unknown keyword %q at line %d", ErrInvalidGettext, keyword, i+1)
+		}
+	}
+	flush()
+	return messages, nil
+}
+
+// unquotePO unquotes a C-like string of a PO file.
+func unquotePO(s string) (string, error) {
+	if len(s) < 2 || s[0] != '"' || s[len(s)-1] != '"' {
+		return "", fmt.Errorf("invalid string %s", s)
+	}
+	s = s[1 : len(s)-1]
+	if !strings.Contains(s, `\`) {
+		return s, nil
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i+1 == len(s) {
+			b.WriteByte(s[i])
+			continue
+		}
+		i++
+		switch s[i] {
+		case 'n':
+			b.WriteByte('\n')
+		case 't':
+			b.WriteByte('\t')
+		case 'r':
+			b.WriteByte('\r')
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	return b.String(), nil
+}
+
+// quotePO quotes a string for a PO file, the multi-line strings are split by line.
+func quotePO(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\t", `\t`, "\r", `\r`, "\n", `\n`)
+	lines := strings.SplitAfter(s, "\n")
+	if len(lines) == 1 || (len(lines) == 2 && lines[1] == "") {
+		return `"` + r.Replace(s) + `"`
+	}
+	var b strings.Builder
+	b.WriteString(`""`)
+	for _, line := range lines {
+		if line != "" {
+			b.WriteString("\n\"" + r.Replace(line) + `"`)
+		}
+	}
+	return b.String()
+}
+
+// parseMO parses the entries of a MO file.
+func parseMO(data []byte) ([]gettextMessage, error) {
+	if len(data) < 28 {
+		return nil, fmt.Errorf("%w: file too short", ErrInvalidGettext)
+	}
+	var order binary.ByteOrder
+	switch binary.LittleEndian.Uint32(data) {
+	case 0x950412de:
+		order = binary.LittleEndian
+	case 0xde120495:
+		order = binary.BigEndian
+	default:
+		return nil, fmt.Errorf("%w: bad magic number", ErrInvalidGettext)
+	}
+
+	count := int(order.Uint32(data[8:]))
+	originals := int(order.Uint32(data[12:]))
+	translations := int(order.Uint32(data[16:]))
+	// The count is checked against the size of the tables before allocating the messages.
+	for _, table := range []int{originals, translations} {
+		if count < 0 || table < 0 || table > len(data) || count > (len(data)-table)/8 {
+			return nil, fmt.Errorf("%w: bad string table", ErrInvalidGettext)
+		}
+	}
+	str := func(table, i int) (string, error) {
+		at := table + i*8
+		if at < 0 || at+8 > len(data) {
+			return "", fmt.Errorf("%w: bad string table", ErrInvalidGettext)
+		}
+		length, offset := int(order.Uint32(data[at:])), int(order.Uint32(data[at+4:]))
+		if offset < 0 || length < 0 || offset+length > len(data) {
+			return "", fmt.Errorf("%w: bad string offset", ErrInvalidGettext)
+		}
+		return string(data[offset : offset+length]), nil
+	}
+
+	messages := make([]gettextMessage, 0, count)
+	for i := 0; i < count; i++ {
+		id, err := str(originals, i)
+		if err != nil {
+			return nil, err
+		}
+		s, err := str(translations, i)
+		if err != nil {
+			return nil, err
+		}
+		var m gettextMessage
+		if ctx, rest, ok := strings.Cut(id, "\x04"); ok {
+			m.context, id = ctx, rest
+		}
+		m.id, m.idPlural, _ = strings.Cut(id, "\x00")
+		m.strs = strings.Split(s, "\x00")
+		messages = append(messages, m)
+	}
+	return messages, nil
+}
+
+// gettextPluralForms maps the gettext plural forms to the CLDR categories.
+type gettextPluralForms struct {
+	// categories are the CLDR categories in order, and indexes their forms.
+	categories []string
+	indexes    map[string]int
+}
+
+// newGettextPluralForms evaluates the `Plural-Forms` expression for the integers and matches the forms
+// with the CLDR categories of the language.
+func newGettextPluralForms(header, lang string) (*gettextPluralForms, error) {
+	nplurals, expr := 2, "n != 1"
+	for _, part := range strings.Split(header, ";") {
+		k, v, _ := strings.Cut(strings.TrimSpace(part), "=")
+		switch strings.TrimSpace(k) {
+		case "nplurals":
+			n, err := strconv.Atoi(strings.TrimSpace(v))
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("%w: bad nplurals %q", ErrInvalidGettext, v)
+			}
+			nplurals = n
+		case "plural":
+			expr = strings.TrimSpace(v)
+		}
+	}
+	eval, err := parsePluralExpr(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	fn, ok := DefaultPluralRules.PluralFunc(strings.ToLower(strings.SplitN(strings.ReplaceAll(lang, "_", "-"), "-", 2)[0]))
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedLanguage, lang)
+	}
+
+	forms := &gettextPluralForms{indexes: make(map[string]int)}
+	for n := 0; n <= 1000; n++ {
+		category := fn(n, false)
+		if _, ok := forms.indexes[category]; ok {
+			continue
+		}
+		i := eval(n)
+		if i < 0 || i >= nplurals {
+			return nil, fmt.Errorf("%w: plural form %d of %d is out of range", ErrInvalidGettext, i, n)
+		}
+		forms.indexes[category] = i
+		forms.categories = append(forms.categories, category)
+	}
+	if _, ok := forms.indexes["other"]; !ok {
+		// The `other` category of some languages only applies to the fractions.
+		forms.indexes["other"] = nplurals - 1
+		forms.categories = append(forms.categories, "other")
+	}
+	return forms, nil
+}
+
+// icu converts the translated forms to an ICU `plural` argument, false if a form is not translated.
+func (f *gettextPluralForms) icu(strs []string) (string, bool) {
+	var b strings.Builder
+	b.WriteString("{count, plural,")
+	for _, category := range cldrCategoryOrder {
+		i, ok := f.indexes[category]
+		if !ok {
+			continue
+		}
+		if i >= len(strs) || strs[i] == "" {
+			return "", false
+		}
+		b.WriteString(" " + category + " {" + strings.ReplaceAll(strs[i], "%d", "#") + "}")
+	}
+	b.WriteString("}")
+	return b.String(), true
+}
+
+// cldrCategoryOrder is the conventional order of the CLDR plural categories.
+var cldrCategoryOrder = []string{"zero", "one", "two", "few", "many", "other"}
+
+// parsePluralExpr parses the C-like expression of the `Plural-Forms` header.
+func parsePluralExpr(expr string) (func(n int) int, error) {
+	p := &pluralExprParser{input: expr}
+	fn, err := p.ternary()
+	if err == nil && p.skipSpaces() < len(p.input) {
+		err = fmt.Errorf("unexpected %q", p.input[p.pos:])
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: bad plural expression %q: %v", ErrInvalidGettext, expr, err)
+	}
+	return fn, nil
+}
+
+// pluralExprParser is a recursive descent parser of the `Plural-Forms` expressions.
+type pluralExprParser struct {
+	input string
+	pos   int
+}
+
+// skipSpaces
+func (p *pluralExprParser) skipSpaces() int {
+	for p.pos < len(p.input) && unicode.IsSpace(rune(p.input[p.pos])) {
+		p.pos++
+	}
+	return p.pos
+}
+
+// accept consumes the operator if it's next.
+func (p *pluralExprParser) accept(op string) bool {
+	p.skipSpaces()
+	if strings.HasPrefix(p.input[p.pos:], op) {
+		p.pos += len(op)
+		return true
+	}
+	return false
+}
+
+// ternary parses `cond ? a : b`.
+func (p *pluralExprParser) ternary() (func(int) int, error) {
+	cond, err := p.binary(0)
+	if err != nil {
+		return nil, err
+	}
+	if !p.accept("?") {
+		return cond, nil
+	}
+	a, err := p.ternary()
+	if err != nil {
+		return nil, err
+	}
+	if !p.accept(":") {
+		return nil, errors.New("missing :")
+	}
+	b, err := p.ternary()
+	if err != nil {
+		return nil, err
+	}
+	return func(n int) int {
+		if cond(n) != 0 {
+			return a(n)
+		}
+		return b(n)
+	}, nil
+}
+
+// pluralExprLevels are the binary operators by increasing precedence, the longer operators first.
+var pluralExprLevels = [][]string{
+	{"||"},
+	{"&&"},
+	{"==", "!="},
+	{"<=", ">=", "<", ">"},
+	{"+", "-"},
+	{"*", "/", "%"},
+}
+
+// binary parses the binary operators of a precedence level.
+func (p *pluralExprParser) binary(level int) (func(int) int, error) {
+	if level == len(pluralExprLevels) {
+		return p.unary()
+	}
+	left, err := p.binary(level + 1)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op := ""
+		for _, o := range pluralExprLevels[level] {
+			if p.skipSpaces(); strings.HasPrefix(p.input[p.pos:], o) {
+				op = o
+				break
+			}
+		}
+		if op == "" {
+			return left, nil
+		}
+		p.pos += len(op)
+		right, err := p.binary(level + 1)
+		if err != nil {
+			return nil, err
+		}
+		left = pluralBinaryOp(op, left, right)
+	}
+}
+
+// pluralBinaryOp
+func pluralBinaryOp(op string, a, b func(int) int) func(int) int {
+	boolean := func(v bool) int {
+		if v {
+			return 1
+		}
+		return 0
+	}
+	switch op {
+	case "||":
+		return func(n int) int { return boolean(a(n) != 0 || b(n) != 0) }
+	case "&&":
+		return func(n int) int { return boolean(a(n) != 0 && b(n) != 0) }
+	case "==":
+		return func(n int) int { return boolean(a(n) == b(n)) }
+	case "!=":
+		return func(n int) int { return boolean(a(n) != b(n)) }
+	case "<=":
+		return func(n int) int { return boolean(a(n) <= b(n)) }
+	case ">=":
+		return func(n int) int { return boolean(a(n) >= b(n)) }
+	case "<":
+		return func(n int) int { return boolean(a(n) < b(n)) }
+	case ">":
+		return func(n int) int { return boolean(a(n) > b(n)) }
+	case "+":
+		return func(n int) int { return a(n) + b(n) }
+	case "-":
+		return func(n int) int { return a(n) - b(n) }
+	case "*":
+		return func(n int) int { return a(n) * b(n) }
+	case "/":
+		return func(n int) int {
+			if d := b(n); d != 0 {
+				return a(n) / d
+			}
+			return 0
+		}
+	default:
+		return func(n int) int {
+			if d := b(n); d != 0 {
+				return a(n) % d
+			}
+			return 0
+		}
+	}
+}
+
+// unary parses `!x`, `(x)`, `n` and the integers.
+func (p *pluralExprParser) unary() (func(int) int, error) {
+	p.skipSpaces()
+	if p.pos >= len(p.input) {
+		return nil, errors.New("unexpected end")
+	}
+	switch c := p.input[p.pos]; {
+	case c == '!':
+		p.pos++
+		x, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return func(n int) int {
+			if x(n) == 0 {
+				return 1
+			}
+			return 0
+		}, nil
+	case c == '(':
+		p.pos++
+		x, err := p.ternary()
+		if err != nil {
+			return nil, err
+		}
+		if !p.accept(")") {
+			return nil, errors.New("missing )")
+		}
+		return x, nil
+	case c == 'n':
+		p.pos++
+		return func(n int) int { return n }, nil
+	case c >= '0' && c <= '9':
+		start := p.pos
+		for p.pos < len(p.input) && p.input[p.pos] >= '0' && p.input[p.pos] <= '9' {
+			p.pos++
+		}
+		v, _ := strconv.Atoi(p.input[start:p.pos])
+		return func(int) int { return v }, nil
+	default:
+		return nil, fmt.Errorf("unexpected %q", c)
+	}
+}
@@ -0,0 +1,163 @@
+package i18n
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnmarshalPO(t *testing.T) {
+	assert := assert.New(t)
+
+	b, err := os.ReadFile("test/ru.po")
+	assert.NoError(err)
+	var messages map[string]string
+	assert.NoError(UnmarshalPO(b, &messages))
+	assert.Equal(map[string]string{
+		"hello":       "Привет",
+		"Post <verb>": "Опубликовать",
+		"Post <noun>": "Запись",
+		"files":       "{count, plural, one {# файл} few {# файла} many {# файлов} other {# файлов}}",
+		"multiline":   "Первая строка\nВторая \"строка\"",
+	}, messages)
+
+	bundle := NewBundle(
+		WithDefaultLocale("ru"),
+		WithUnmarshaler(UnmarshalPO),
+	)
+	assert.NoError(bundle.LoadFiles("test/ru.po"))
+	localizer := bundle.NewLocalizer("ru")
+	assert.Equal("Запись", localizer.GetX("Post", "noun"))
+	assert.Equal("21 файл", localizer.Get("files", Vars{"count": 21}))
+	assert.Equal("3 файла", localizer.Get("files", Vars{"count": 3}))
+	assert.Equal("11 файлов", localizer.Get("files", Vars{"count": 11}))
+
+	for _, data := range []string{
+		"msgid \"a\"\nmsgstr[1] \"b\"",
+		"\"orphan\"",
+		"msgid \"a\"\nmsgfoo \"b\"",
+		"msgid \"\"\nmsgstr \"Plural-Forms: nplurals=2; plural=n +;\\n\"\nmsgid \"a\"\nmsgid_plural \"a\"\nmsgstr[0] \"a\"\nmsgstr[1] \"b\"",
+	} {
+		assert.True(errors.Is(UnmarshalPO([]byte(data), &messages), ErrInvalidGettext), data)
+	}
+}
+
+func TestPluralExpr(t *testing.T) {
+	assert := assert.New(t)
+
+	fn, err := parsePluralExpr("n==0 ? 0 : n==1 ? 1 : n==2 ? 2 : n%100>=3 && n%100<=10 ? 3 : n%100>=11 ? 4 : 5")
+	assert.NoError(err)
+	for n, want := range map[int]int{0: 0, 1: 1, 2: 2, 3: 3, 110: 3, 11: 4, 99: 4, 100: 5, 102: 5} {
+		assert.Equal(want, fn(n), n)
+	}
+	fn, err = parsePluralExpr("(n != 1)")
+	assert.NoError(err)
+	assert.Equal(0, fn(1))
+	assert.Equal(1, fn(5))
+	fn, err = parsePluralExpr("!(n > 1) + 2 * 3 - 6 / 2")
+	assert.NoError(err)
+	assert.Equal(4, fn(1))
+}
+
+func encodeMO(entries map[string]string) []byte {
+	keys := make([]string, 0, len(entries))
+	for k := range entries {
+		keys = append(keys, k)
+	}
+	n := len(keys)
+	var ids, strs bytes.Buffer
+	offset := 28 + n*16
+	table := make([]uint32, 0, n*4)
+	for _, k := range keys {
+		table = append(table, uint32(len(k)), uint32(offset+ids.Len()))
+		ids.WriteString(k + "\x00")
+	}
+	for _, k := range keys {
+		table = append(table, uint32(len(entries[k])), uint32(offset+ids.Len()+strs.Len()))
+		strs.WriteString(entries[k] + "\x00")
+	}
+	var b bytes.Buffer
+	for _, v := range []uint32{0x950412de, 0, uint32(n), 28, uint32(28 + n*8), 0, 0} {
+		_ = binary.Write(&b, binary.LittleEndian, v)
+	}
+	_ = binary.Write(&b, binary.LittleEndian, table)
+	b.Write(ids.Bytes())
+	b.Write(strs.Bytes())
+	return b.Bytes()
+}
+
+func TestUnmarshalMO(t *testing.T) {
+	assert := assert.New(t)
+
+	data := encodeMO(map[string]string{
+		"":                 "Language: de\nPlural-Forms: nplurals=2; plural=(n != 1);\n",
+		"hello":            "Hallo",
+		"verb\x04Post":     "Veröffentlichen",
+		"file\x00files":    "%d Datei\x00%d Dateien",
+		"empty\x00empties": "\x00",
+	})
+	var messages map[string]string
+	assert.NoError(UnmarshalMO(data, &messages))
+	assert.Equal(map[string]string{
+		"hello":       "Hallo",
+		"Post <verb>": "Veröffentlichen",
+		"file":        "{count, plural, one {# Datei} other {# Dateien}}",
+	}, messages)
+
+	assert.True(errors.Is(UnmarshalMO([]byte("not a mo file, not a mo file"), &messages), ErrInvalidGettext))
+}
+
+func TestUnmarshalMOMalformedHeader(t *testing.T) {
+	assert := assert.New(t)
+
+	valid := encodeMO(map[string]string{"hello": "Hallo"})
+	for name, header := range map[string][]uint32{
+		"huge count":                 {0x950412de, 0, 0xffffffff, 28, 36},
+		"originals past the end":     {0x950412de, 0, 1, 0xfffffff0, 36},
+		"translations past the end":  {0x950412de, 0, 1, 28, uint32(len(valid))},
+		"translations at the header": {0x950412de, 0, 1, 28, uint32(len(valid) - 4)},
+	} {
+		data := bytes.Clone(valid)
+		for i, v := range header {
+			binary.LittleEndian.PutUint32(data[i*4:], v)
+		}
+		var messages map[string]string
+		assert.ErrorIs(UnmarshalMO(data, &messages), ErrInvalidGettext, name)
+	}
+}
+
+func TestExportPO(t *testing.T) {
+	assert := assert.New(t)
+
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "zh-Hans"),
+	)
+	assert.NoError(bundle.LoadMessages(map[string]map[string]string{
+		"en":      {"hello": "Hello", "Post <verb>": "Post", "bye": "Bye\nSee you"},
+		"zh-Hans": {"hello": "你好", "Post <verb>": "发布"},
+	}))
+
+	var b strings.Builder
+	assert.NoError(bundle.ExportPO(&b, "zh-Hans"))
+	assert.Contains(b.String(), "\"Language: zh-Hans\\n\"\n")
+	assert.Contains(b.String(), "\nmsgctxt \"verb\"\nmsgid \"Post\"\nmsgstr \"发布\"\n")
+	assert.Contains(b.String(), "\nmsgid \"bye\"\nmsgstr \"\"\n")
+
+	var messages map[string]string
+	assert.NoError(UnmarshalPO([]byte(b.String()), &messages))
+	assert.Equal(map[string]string{"hello": "你好", "Post <verb>": "发布"}, messages)
+
+	b.Reset()
+	assert.NoError(bundle.ExportPO(&b, "en"))
+	messages = nil
+	assert.NoError(UnmarshalPO([]byte(b.String()), &messages))
+	assert.Equal("Bye\nSee you", messages["bye"])
+
+	assert.ErrorIs(bundle.ExportPO(&b, "fr"), ErrInvalidLocale)
+}
@@ -0,0 +1,92 @@
+package i18n
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	"golang.org/x/text/language"
+)
+
+// defaultBundle is the bundle used by the package-level helpers.
+var defaultBundle atomic.Pointer[I18n]
+
+// SetDefault sets the bundle used by the package-level helpers `T`, `Tf` and `Tx`,
+// so small applications and scripts don't need to thread a bundle through every function.
+func SetDefault(bundle *I18n) {
+	defaultBundle.Store(bundle)
+}
+
+// Default returns the bundle set by `SetDefault`, nil if none.
+func Default() *I18n {
+	return defaultBundle.Load()
+}
+
+// localizerContextKey
+type localizerContextKey struct{}
+
+// NewContext returns a copy of the context that carries the localizer, see `FromContext`.
+func NewContext(ctx context.Context, localizer *Localizer) context.Context {
+	return context.WithValue(ctx, localizerContextKey{}, localizer)
+}
+
+// FromContext returns the localizer carried by the context, nil if none.
+func FromContext(ctx context.Context) *Localizer {
+	localizer, _ := ctx.Value(localizerContextKey{}).(*Localizer)
+	return localizer
+}
+
+// T returns a translated string like `Localizer.Get`. The localizer is resolved from `localeOrCtx`:
+// a `*Localizer`, a `context.Context` carrying one (see `NewContext`), or a locale (`string` or `language.Tag`)
+// of the default bundle. The default locale of the default bundle is used otherwise.
+//
+//	i18n.T("zh-Hans", "hello_world")
+//	i18n.T(r.Context(), "hello_name", i18n.Vars{"name": name})
+func T(localeOrCtx any, name string, data ...Vars) string {
+	localizer := localizerOf(localeOrCtx)
+	if localizer == nil {
+		name, _ = splitContext(name)
+		return name
+	}
+	return localizer.Get(name, data...)
+}
+
+// Tf returns a translated string with sprintf support like `Localizer.Getf`, see `T`.
+func Tf(localeOrCtx any, name string, data ...interface{}) string {
+	localizer := localizerOf(localeOrCtx)
+	if localizer == nil {
+		name, _ = splitContext(name)
+		return fmt.Sprintf(name, data...)
+	}
+	return localizer.Getf(name, data...)
+}
+
+// Tx returns a translated string with a specified context like `Localizer.GetX`, see `T`.
+func Tx(localeOrCtx any, name, context string, data ...Vars) string {
+	return T(localeOrCtx, withContext(name, context), data...)
+}
+
+// localizerOf resolves the localizer of the package-level helpers, nil if there's no default bundle to fall back to.
+func localizerOf(localeOrCtx any) *Localizer {
+	var locales []string
+	switch v := localeOrCtx.(type) {
+	case *Localizer:
+		if v != nil {
+			return v
+		}
+	case context.Context:
+		if localizer := FromContext(v); localizer != nil {
+			return localizer
+		}
+	case string:
+		locales = append(locales, v)
+	case language.Tag:
+		locales = append(locales, v.String())
+	}
+
+	bundle := Default()
+	if bundle == nil {
+		return nil
+	}
+	return bundle.NewLocalizer(locales...)
+}
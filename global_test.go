@@ -0,0 +1,55 @@
+package i18n
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+)
+
+func TestDefaultBundle(t *testing.T) {
+	assert := assert.New(t)
+
+	SetDefault(nil)
+	assert.Nil(Default())
+	assert.Equal("hello_world", T("zh-Hans", "hello_world"))
+	assert.Equal("Post", Tx("zh-Hans", "Post", "verb"))
+
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "zh-Hans"),
+	)
+	bundle.LoadMessages(map[string]map[string]string{
+		"en": {
+			"hello_world":   "Hello, world",
+			"hello_name":    "Hello, {name}",
+			"hello_sprintf": "Hello, %s",
+			"Post <verb>":   "Post",
+		},
+		"zh-Hans": {
+			"hello_world":   "你好，世界",
+			"hello_name":    "你好，{name}",
+			"hello_sprintf": "你好，%s",
+			"Post <verb>":   "发布",
+		},
+	})
+	SetDefault(bundle)
+	t.Cleanup(func() { SetDefault(nil) })
+	assert.Same(bundle, Default())
+
+	assert.Equal("你好，世界", T("zh-Hans", "hello_world"))
+	assert.Equal("你好，世界", T(language.SimplifiedChinese, "hello_world"))
+	assert.Equal("Hello, world", T(nil, "hello_world"))
+	assert.Equal("你好，Yami", T("zh-Hans", "hello_name", Vars{"name": "Yami"}))
+	assert.Equal("你好，Yami", Tf("zh-Hans", "hello_sprintf", "Yami"))
+	assert.Equal("发布", Tx("zh-Hans", "Post", "verb"))
+
+	ctx := NewContext(context.Background(), bundle.NewLocalizer("zh-Hans"))
+	assert.Equal("zh-Hans", FromContext(ctx).Locale())
+	assert.Equal("你好，世界", T(ctx, "hello_world"))
+	assert.Equal("Hello, world", T(context.Background(), "hello_world"))
+	assert.Nil(FromContext(context.Background()))
+
+	assert.Equal("你好，世界", T(bundle.NewLocalizer("zh-Hans"), "hello_world"))
+}
@@ -1,13 +1,16 @@
 module github.com/kaptinlin/go-i18n
 
-go 1.21
-toolchain go1.22.5
+go 1.23
 
 require (
+	github.com/fsnotify/fsnotify v1.10.1
 	github.com/goccy/go-json v0.10.3
+	github.com/gotnospirit/makeplural v0.0.0-20180622080156-a5f48d94d976
 	github.com/gotnospirit/messageformat v0.0.0-20221001023931-dfe49f1eb092
+	github.com/klauspost/compress v1.17.11
 	github.com/pelletier/go-toml/v2 v2.2.3
 	github.com/stretchr/testify v1.9.0
+	golang.org/x/sync v0.10.0
 	golang.org/x/text v0.19.0
 	gopkg.in/ini.v1 v1.67.0
 	gopkg.in/yaml.v3 v3.0.1
@@ -15,6 +18,6 @@ require (
 
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
-	github.com/gotnospirit/makeplural v0.0.0-20180622080156-a5f48d94d976 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
+	golang.org/x/sys v0.13.0 // indirect
 )
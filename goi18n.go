@@ -0,0 +1,177 @@
+package i18n
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidGoI18n is returned when a nicksnyder/go-i18n message file cannot be parsed.
+var ErrInvalidGoI18n = errors.New("i18n: invalid go-i18n file")
+
+// goI18nReservedKeys are the fields of a nicksnyder/go-i18n message.
+var goI18nReservedKeys = []string{"id", "description", "hash", "leftdelim", "rightdelim", "zero", "one", "two", "few", "many", "other"}
+
+// GoI18nUnmarshaler returns an `Unmarshaler` for the nicksnyder/go-i18n v2 message files, the files are decoded
+// by the unmarshaler of their format (e.g. `toml.Unmarshal`), to be used with `WithUnmarshaler`.
+//
+// The messages are converted to ICU MessageFormat: the plural forms (`one`, `other`...) become a `plural` argument
+// on `Count`, the template actions (`{{.Name}}`) become arguments and `{{.Count}}` becomes `#` in the plurals.
+// The descriptions are ignored, and the nested messages are named like `parent.child`.
+func GoI18nUnmarshaler(unmarshaler Unmarshaler) Unmarshaler {
+	return func(data []byte, v any) error {
+		out, ok := v.(*map[string]string)
+		if !ok {
+			return fmt.Errorf("%w: unsupported target %T", ErrInvalidGoI18n, v)
+		}
+		if *out == nil {
+			*out = make(map[string]string)
+		}
+
+		var raw map[string]any
+		if err := safeUnmarshal(unmarshaler, data, &raw); err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidGoI18n, err)
+		}
+		return addGoI18nMessages(*out, "", raw)
+	}
+}
+
+// addGoI18nMessages adds the messages of a go-i18n file, the maps that aren't messages are nested messages.
+func addGoI18nMessages(messages map[string]string, prefix string, v any) error {
+	join := func(key string) string {
+		if prefix == "" {
+			return key
+		}
+		return prefix + "." + key
+	}
+	switch v := v.(type) {
+	case string:
+		messages[prefix] = convertGoTemplate(v, "{{", "}}", false)
+	case map[string]any:
+		if isGoI18nMessage(v) {
+			return addGoI18nMessage(messages, prefix, v)
+		}
+		for key, value := range v {
+			if err := addGoI18nMessages(messages, join(key), value); err != nil {
+				return err
+			}
+		}
+	case map[any]any:
+		m := make(map[string]any, len(v))
+		for key, value := range v {
+			m[fmt.Sprint(key)] = value
+		}
+		return addGoI18nMessages(messages, prefix, m)
+	default:
+		return fmt.Errorf("%w: unsupported value %T of %q", ErrInvalidGoI18n, v, prefix)
+	}
+	return nil
+}
+
+// isGoI18nMessage reports whether a map is a message, it's one if a reserved key holds a string.
+func isGoI18nMessage(m map[string]any) bool {
+	for key, value := range m {
+		if _, ok := value.(string); !ok {
+			continue
+		}
+		for _, reserved := range goI18nReservedKeys {
+			if strings.EqualFold(key, reserved) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// addGoI18nMessage converts a message, the `id` field names it if set.
+func addGoI18nMessage(messages map[string]string, name string, m map[string]any) error {
+	fields := make(map[string]string, len(m))
+	for key, value := range m {
+		if s, ok := value.(string); ok {
+			fields[strings.ToLower(key)] = s
+		}
+	}
+	if id := fields["id"]; id != "" {
+		name = id
+	}
+	if name == "" {
+		return fmt.Errorf("%w: message without id", ErrInvalidGoI18n)
+	}
+	left, right := fields["leftdelim"], fields["rightdelim"]
+	if left == "" {
+		left = "{{"
+	}
+	if right == "" {
+		right = "}}"
+	}
+
+	var forms []string
+	for _, category := range cldrCategoryOrder {
+		if _, ok := fields[category]; ok {
+			forms = append(forms, category)
+		}
+	}
+	switch {
+	case len(forms) == 0:
+		return fmt.Errorf("%w: message %q without translation", ErrInvalidGoI18n, name)
+	case len(forms) == 1 && forms[0] == "other":
+		messages[name] = convertGoTemplate(fields["other"], left, right, false)
+		return nil
+	}
+
+	var b strings.Builder
+	b.WriteString("{Count, plural,")
+	for _, category := range forms {
+		b.WriteString(" " + category + " {" + convertGoTemplate(fields[category], left, right, true) + "}")
+	}
+	if _, ok := fields["other"]; !ok {
+		// ICU requires `other`, use the last form.
+		b.WriteString(" other {" + convertGoTemplate(fields[forms[len(forms)-1]], left, right, true) + "}")
+	}
+	b.WriteString("}")
+	messages[name] = b.String()
+	return nil
+}
+
+// convertGoTemplate converts the `{{.Name}}` actions of a template to ICU arguments, `{{.Count}}` becomes `#`
+// in a plural. The other actions (e.g. pipelines) are kept as text.
+func convertGoTemplate(text, left, right string, plural bool) string {
+	var b strings.Builder
+	for {
+		start := strings.Index(text, left)
+		if start < 0 {
+			break
+		}
+		end := strings.Index(text[start+len(left):], right)
+		if end < 0 {
+			break
+		}
+		action := text[start+len(left) : start+len(left)+end]
+		name := strings.TrimSpace(strings.Trim(action, "-"))
+		b.WriteString(escapeICU(text[:start]))
+		switch {
+		case !isGoTemplateField(name):
+			b.WriteString(escapeICU(text[start : start+len(left)+end+len(right)]))
+		case plural && name == ".Count":
+			b.WriteString("#")
+		default:
+			b.WriteString("{" + name[1:] + "}")
+		}
+		text = text[start+len(left)+end+len(right):]
+	}
+	b.WriteString(escapeICU(text))
+	return b.String()
+}
+
+// isGoTemplateField reports whether an action is a field like `.Name`.
+func isGoTemplateField(s string) bool {
+	if len(s) < 2 || s[0] != '.' {
+		return false
+	}
+	for _, r := range s[1:] {
+		if r != '_' && !('a' <= r && r <= 'z' || 'A' <= r && r <= 'Z' || '0' <= r && r <= '9') {
+			return false
+		}
+	}
+	return true
+}
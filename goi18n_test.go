@@ -0,0 +1,47 @@
+package i18n
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/goccy/go-json"
+	"github.com/pelletier/go-toml/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGoI18nUnmarshaler(t *testing.T) {
+	assert := assert.New(t)
+
+	b, err := os.ReadFile("test/goi18n/es.active.toml")
+	assert.NoError(err)
+	var messages map[string]string
+	assert.NoError(GoI18nUnmarshaler(toml.Unmarshal)(b, &messages))
+	assert.Equal(map[string]string{
+		"HelloPerson":    "Hola {Name}",
+		"PersonCats":     "{Count, plural, one {{Name} tiene # gato.} other {{Name} tiene # gatos.}}",
+		"MyUnreadEmails": "Tengo {Count} correos sin leer.",
+		"Custom":         "Hola {Name} \\{\\{.Name\\}\\}",
+		"nav.home":       "Inicio",
+		"nav.title":      "Menú \\{\\{.Name | upper\\}\\}",
+	}, messages)
+
+	bundle := NewBundle(
+		WithDefaultLocale("es"),
+		WithUnmarshaler(GoI18nUnmarshaler(toml.Unmarshal)),
+	)
+	assert.NoError(bundle.LoadFiles("test/goi18n/es.active.toml"))
+	localizer := bundle.NewLocalizer("es")
+	assert.Equal("Hola Yami", localizer.Get("HelloPerson", Vars{"Name": "Yami"}))
+	assert.Equal("Yami tiene 1 gato.", localizer.Get("PersonCats", Vars{"Name": "Yami", "Count": 1}))
+	assert.Equal("Yami tiene 2 gatos.", localizer.Get("PersonCats", Vars{"Name": "Yami", "Count": 2}))
+
+	for _, data := range []string{
+		`{`,
+		`{"a": 1}`,
+		`{"a": ["b"]}`,
+		`{"a": {"description": "no translation"}}`,
+	} {
+		assert.True(errors.Is(GoI18nUnmarshaler(json.Unmarshal)([]byte(data), &messages), ErrInvalidGoI18n), data)
+	}
+}
@@ -0,0 +1,73 @@
+package i18n
+
+// FallbackEvent describes a message rendered from a fallback locale, see `WithFallbackHook`.
+type FallbackEvent struct {
+	// Locale is the locale of the localizer.
+	Locale string
+	// FallbackLocale is the locale the text of the message comes from.
+	FallbackLocale string
+	// Name is the message name without the context.
+	Name string
+	// Context is the `GetX` context, empty if none.
+	Context string
+}
+
+// CompileErrorEvent describes a message that doesn't compile and is rendered as its raw text,
+// see `WithCompileErrorHook`.
+type CompileErrorEvent struct {
+	// Locale is the locale of the text of the message.
+	Locale string
+	// Name is the message name without the context.
+	Name string
+	// Context is the `GetX` context, empty if none.
+	Context string
+	// Text is the raw text of the message.
+	Text string
+	// Err is the error of the compiler.
+	Err error
+}
+
+// WithFallbackHook registers a hook that is called every time a message is rendered from a fallback locale
+// because the locale of the localizer doesn't translate it. The hook must be safe for concurrent use.
+func WithFallbackHook(hook func(FallbackEvent)) func(*I18n) {
+	return func(bundle *I18n) {
+		bundle.fallbackHooks = append(bundle.fallbackHooks, hook)
+	}
+}
+
+// WithCompileErrorHook registers a hook that is called every time a message that doesn't compile is rendered
+// as its raw text, like the broken messages of `WithLazyCompile` and the text-based names parsed at runtime.
+// The hook must be safe for concurrent use.
+func WithCompileErrorHook(hook func(CompileErrorEvent)) func(*I18n) {
+	return func(bundle *I18n) {
+		bundle.compileErrorHooks = append(bundle.compileErrorHooks, hook)
+	}
+}
+
+// reportFallback reports the rendering of a translation of a fallback locale to the hooks of the bundle.
+func (localizer *Localizer) reportFallback(trans *parsedTranslation) {
+	event := FallbackEvent{
+		Locale:         localizer.locale,
+		FallbackLocale: trans.locale,
+	}
+	event.Name, event.Context = splitContext(trans.name)
+	for _, hook := range localizer.bundle.fallbackHooks {
+		hook(event)
+	}
+}
+
+// reportCompileError reports a translation that doesn't compile to the hooks of the bundle.
+func (bundle *I18n) reportCompileError(trans *parsedTranslation, err error) {
+	if len(bundle.compileErrorHooks) == 0 {
+		return
+	}
+	event := CompileErrorEvent{
+		Locale: trans.locale,
+		Text:   trans.text,
+		Err:    err,
+	}
+	event.Name, event.Context = splitContext(trans.name)
+	for _, hook := range bundle.compileErrorHooks {
+		hook(event)
+	}
+}
@@ -0,0 +1,61 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFallbackHook(t *testing.T) {
+	assert := assert.New(t)
+
+	var events []FallbackEvent
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "zh-Hans"),
+		WithFallbackHook(func(e FallbackEvent) {
+			events = append(events, e)
+		}),
+	)
+	assert.NoError(bundle.LoadMessages(map[string]map[string]string{
+		"en":      {"hello": "Hello", "bye": "Bye, {name}", "Post <verb>": "Post"},
+		"zh-Hans": {"hello": "你好"},
+	}))
+	localizer := bundle.NewLocalizer("zh-Hans")
+
+	assert.Equal("你好", localizer.Get("hello"))
+	assert.Equal("Bye, Yami", localizer.Get("bye", Vars{"name": "Yami"}))
+	assert.Equal("Post", localizer.GetX("Post", "verb"))
+	assert.Equal("Hello, world", localizer.Get("Hello, world"))
+	assert.Equal("Hello", bundle.NewLocalizer("en").Get("hello"))
+
+	assert.Equal([]FallbackEvent{
+		{Locale: "zh-Hans", FallbackLocale: "en", Name: "bye"},
+		{Locale: "zh-Hans", FallbackLocale: "en", Name: "Post", Context: "verb"},
+	}, events)
+}
+
+func TestCompileErrorHook(t *testing.T) {
+	assert := assert.New(t)
+
+	var events []CompileErrorEvent
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithLazyCompile(true),
+		WithCompileErrorHook(func(e CompileErrorEvent) {
+			events = append(events, e)
+		}),
+	)
+	assert.NoError(bundle.LoadMessages(map[string]map[string]string{
+		"en": {"files": "{count, plural, one {# file}"},
+	}))
+	localizer := bundle.NewLocalizer("en")
+
+	assert.Equal("{count, plural, one {# file}", localizer.Get("files", Vars{"count": 1}))
+	if assert.Len(events, 1) {
+		assert.Equal("en", events[0].Locale)
+		assert.Equal("files", events[0].Name)
+		assert.Equal("{count, plural, one {# file}", events[0].Text)
+		assert.Error(events[0].Err)
+	}
+}
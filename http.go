@@ -0,0 +1,183 @@
+package i18n
+
+import (
+	"fmt"
+	"hash/fnv"
+	"net/http"
+	"strings"
+
+	"github.com/goccy/go-json"
+)
+
+// Messages returns the raw translations of a locale, including the ones resolved from the fallbacks.
+// The result is nil if the locale is not supported.
+func (bundle *I18n) Messages(locale string) map[string]string {
+	bundle.mu.RLock()
+	defer bundle.mu.RUnlock()
+
+	locale = bundle.getExactSupportedLocale(locale)
+	if locale == "" {
+		return nil
+	}
+	messages := make(map[string]string, len(bundle.parsedTranslations[locale]))
+	for name, trans := range bundle.parsedTranslations[locale] {
+		messages[name] = trans.text
+	}
+	return messages
+}
+
+// ClientMessages returns the raw translations of a locale like `Messages`,
+// but only keeps the messages that are visible to the clients.
+func (bundle *I18n) ClientMessages(locale string) map[string]string {
+	messages := bundle.Messages(locale)
+	for name := range messages {
+		if !bundle.IsClientVisible(name) {
+			delete(messages, name)
+		}
+	}
+	return messages
+}
+
+// IsClientVisible indicates whether a message can be shipped to the clients,
+// see `WithClientPrefixes` and `WithServerOnlyPrefixes`.
+func (bundle *I18n) IsClientVisible(name string) bool {
+	if hasAnyPrefix(name, bundle.serverOnlyPrefixes) {
+		return false
+	}
+	return len(bundle.clientPrefixes) == 0 || hasAnyPrefix(name, bundle.clientPrefixes)
+}
+
+// CatalogHandler returns a `http.Handler` that serves the client-visible messages of a locale as a flat JSON object,
+// so frontends (i18next, vue-i18n...) can consume the same catalogs as the backend.
+//
+// The locale is read from the request path, mount the handler with `http.StripPrefix`:
+//
+//	GET /zh-Hans           all the messages of `zh-Hans`.
+//	GET /zh-Hans.json      same as above.
+//	GET /zh-Hans/errors    the messages prefixed with `errors.`, the prefix is trimmed from the keys.
+//	GET /zh-Hans?prefix=a. the messages prefixed with `a.`, the query can be repeated.
+//
+// Responses carry an `ETag` header, and `If-None-Match` requests are answered with `304 Not Modified`.
+func (bundle *I18n) CatalogHandler() http.Handler {
+	return &catalogHandler{bundle: bundle}
+}
+
+// catalogHandler
+type catalogHandler struct {
+	bundle *I18n
+}
+
+// ServeHTTP
+func (h *catalogHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	locale, namespace, _ := strings.Cut(strings.Trim(r.URL.Path, "/"), "/")
+	locale = strings.TrimSuffix(locale, ".json")
+	namespace = strings.TrimSuffix(namespace, ".json")
+
+	messages := h.bundle.ClientMessages(locale)
+	if messages == nil {
+		http.NotFound(w, r)
+		return
+	}
+	if namespace != "" {
+		messages = trimMessagesPrefix(messages, namespace+".")
+	}
+	if prefixes := r.URL.Query()["prefix"]; len(prefixes) > 0 {
+		messages = filterMessagesPrefix(messages, prefixes...)
+	}
+
+	body, err := json.Marshal(messages)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	hash := fnv.New64a()
+	_, _ = hash.Write(body)
+	etag := fmt.Sprintf(`"%x"`, hash.Sum64())
+
+	w.Header().Set("ETag", etag)
+	if match := r.Header.Get("If-None-Match"); match != "" && etagMatches(match, etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	if r.Method == http.MethodHead {
+		return
+	}
+	_, _ = w.Write(body)
+}
+
+// SetLanguageHeaders sets the negotiation headers of a response with `SetContentLanguage` and `VaryAcceptLanguage`.
+//
+//	i18n.SetLanguageHeaders(w.Header(), localizer)
+func SetLanguageHeaders(h http.Header, localizer *Localizer) {
+	SetContentLanguage(h, localizer)
+	VaryAcceptLanguage(h)
+}
+
+// SetContentLanguage sets the `Content-Language` header to the locale of the localizer.
+func SetContentLanguage(h http.Header, localizer *Localizer) {
+	h.Set("Content-Language", localizer.Locale())
+}
+
+// VaryAcceptLanguage appends `Accept-Language` to the `Vary` header if it's not there yet,
+// so the caches know the response was negotiated.
+func VaryAcceptLanguage(h http.Header) {
+	for _, value := range h.Values("Vary") {
+		for _, field := range strings.Split(value, ",") {
+			field = strings.TrimSpace(field)
+			if field == "*" || strings.EqualFold(field, "Accept-Language") {
+				return
+			}
+		}
+	}
+	h.Add("Vary", "Accept-Language")
+}
+
+// filterMessagesPrefix keeps the messages that start with one of the prefixes.
+func filterMessagesPrefix(messages map[string]string, prefixes ...string) map[string]string {
+	filtered := make(map[string]string)
+	for name, text := range messages {
+		if hasAnyPrefix(name, prefixes) {
+			filtered[name] = text
+		}
+	}
+	return filtered
+}
+
+// hasAnyPrefix reports whether the name starts with one of the prefixes.
+func hasAnyPrefix(name string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// trimMessagesPrefix keeps the messages that start with the prefix, and trims the prefix from the names.
+func trimMessagesPrefix(messages map[string]string, prefix string) map[string]string {
+	trimmed := make(map[string]string)
+	for name, text := range messages {
+		if strings.HasPrefix(name, prefix) {
+			trimmed[strings.TrimPrefix(name, prefix)] = text
+		}
+	}
+	return trimmed
+}
+
+// etagMatches reports whether the `If-None-Match` header matches the etag.
+func etagMatches(header, etag string) bool {
+	for _, v := range strings.Split(header, ",") {
+		v = strings.TrimPrefix(strings.TrimSpace(v), "W/")
+		if v == "*" || v == etag {
+			return true
+		}
+	}
+	return false
+}
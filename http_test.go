@@ -0,0 +1,148 @@
+package i18n
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/goccy/go-json"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestCatalogBundle() *I18n {
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "zh-Hans"),
+	)
+	bundle.LoadMessages(map[string]map[string]string{
+		"en": {
+			"hello":              "Hello, {name}",
+			"errors.not_found":   "Not found",
+			"errors.forbidden":   "Forbidden",
+			"settings.title":     "Settings",
+			"settings.save_hint": "Press save",
+		},
+		"zh-Hans": {
+			"hello":            "你好，{name}",
+			"errors.not_found": "找不到",
+		},
+	})
+	return bundle
+}
+
+func serveCatalog(handler http.Handler, target string, header http.Header) (*httptest.ResponseRecorder, map[string]string) {
+	req := httptest.NewRequest(http.MethodGet, target, nil)
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	var messages map[string]string
+	if rec.Code == http.StatusOK {
+		_ = json.Unmarshal(rec.Body.Bytes(), &messages)
+	}
+	return rec, messages
+}
+
+func TestMessages(t *testing.T) {
+	assert := assert.New(t)
+	bundle := newTestCatalogBundle()
+
+	messages := bundle.Messages("zh-Hans")
+	assert.Equal("你好，{name}", messages["hello"])
+	assert.Equal("Forbidden", messages["errors.forbidden"])
+	assert.Nil(bundle.Messages("fr"))
+}
+
+func TestCatalogHandler(t *testing.T) {
+	assert := assert.New(t)
+	handler := newTestCatalogBundle().CatalogHandler()
+
+	rec, messages := serveCatalog(handler, "/zh-Hans.json", nil)
+	assert.Equal(http.StatusOK, rec.Code)
+	assert.Equal("application/json; charset=utf-8", rec.Header().Get("Content-Type"))
+	assert.Len(messages, 5)
+	assert.Equal("找不到", messages["errors.not_found"])
+
+	_, messages = serveCatalog(handler, "/zh-Hans/errors", nil)
+	assert.Equal(map[string]string{"not_found": "找不到", "forbidden": "Forbidden"}, messages)
+
+	_, messages = serveCatalog(handler, "/en?prefix=settings.&prefix=hello", nil)
+	assert.Equal(map[string]string{
+		"hello":              "Hello, {name}",
+		"settings.title":     "Settings",
+		"settings.save_hint": "Press save",
+	}, messages)
+
+	rec, _ = serveCatalog(handler, "/fr", nil)
+	assert.Equal(http.StatusNotFound, rec.Code)
+}
+
+func TestCatalogHandlerETag(t *testing.T) {
+	assert := assert.New(t)
+	handler := newTestCatalogBundle().CatalogHandler()
+
+	rec, _ := serveCatalog(handler, "/en", nil)
+	etag := rec.Header().Get("ETag")
+	assert.NotEmpty(etag)
+
+	rec, _ = serveCatalog(handler, "/en", http.Header{"If-None-Match": {etag}})
+	assert.Equal(http.StatusNotModified, rec.Code)
+	assert.Empty(rec.Body.String())
+
+	rec, _ = serveCatalog(handler, "/zh-Hans", http.Header{"If-None-Match": {etag}})
+	assert.Equal(http.StatusOK, rec.Code)
+	assert.NotEqual(etag, rec.Header().Get("ETag"))
+}
+
+func TestClientMessages(t *testing.T) {
+	assert := assert.New(t)
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithClientPrefixes("errors.", "settings."),
+		WithServerOnlyPrefixes("settings.admin."),
+	)
+	bundle.LoadMessages(map[string]map[string]string{
+		"en": {
+			"hello":                 "Hello",
+			"errors.not_found":      "Not found",
+			"settings.title":        "Settings",
+			"settings.admin.secret": "Rotate the signing key",
+		},
+	})
+
+	assert.True(bundle.IsClientVisible("errors.not_found"))
+	assert.False(bundle.IsClientVisible("hello"))
+	assert.False(bundle.IsClientVisible("settings.admin.secret"))
+	assert.Equal(map[string]string{
+		"errors.not_found": "Not found",
+		"settings.title":   "Settings",
+	}, bundle.ClientMessages("en"))
+	assert.Len(bundle.Messages("en"), 4)
+
+	_, messages := serveCatalog(bundle.CatalogHandler(), "/en/settings", nil)
+	assert.Equal(map[string]string{"title": "Settings"}, messages)
+}
+
+func TestSetLanguageHeaders(t *testing.T) {
+	assert := assert.New(t)
+	localizer := newTestCatalogBundle().NewLocalizer("zh-Hans")
+
+	h := http.Header{}
+	SetLanguageHeaders(h, localizer)
+	assert.Equal("zh-Hans", h.Get("Content-Language"))
+	assert.Equal([]string{"Accept-Language"}, h.Values("Vary"))
+
+	h = http.Header{"Vary": {"Accept-Encoding, accept-language"}}
+	VaryAcceptLanguage(h)
+	assert.Equal([]string{"Accept-Encoding, accept-language"}, h.Values("Vary"))
+
+	h = http.Header{"Vary": {"Accept-Encoding"}}
+	VaryAcceptLanguage(h)
+	assert.Equal([]string{"Accept-Encoding", "Accept-Language"}, h.Values("Vary"))
+
+	h = http.Header{"Vary": {"*"}}
+	VaryAcceptLanguage(h)
+	assert.Equal([]string{"*"}, h.Values("Vary"))
+}
@@ -1,44 +1,178 @@
 package i18n
 
 import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
 	"path/filepath"
 	"regexp"
+	"runtime"
+	"slices"
 	"strings"
+	"sync"
+	"time"
 
-	"github.com/goccy/go-json"
 	"github.com/gotnospirit/messageformat"
+	"golang.org/x/sync/errgroup"
 	"golang.org/x/text/language"
 )
 
+// ErrInvalidLocale is returned when a locale cannot be used by the bundle.
+var ErrInvalidLocale = errors.New("i18n: invalid locale")
+
 // Unmarshaler unmarshals the translation files, can be `json.Unmarshal` or `yaml.Unmarshal`.
 type Unmarshaler func(data []byte, v any) error
 
 // I18n is the main internationalization core.
 type I18n struct {
+	// mu guards the languages, the matcher and the translations, which can change at runtime.
+	mu sync.RWMutex
+
 	defaultLocale             string
 	defaultLanguage           language.Tag
 	languages                 []language.Tag
 	unmarshaler               Unmarshaler
 	languageMatcher           language.Matcher // matcher is a language.Matcher configured for all supported languages.
 	fallbacks                 map[string][]string
+	parentFallbacks           bool
 	parsedTranslations        map[string]map[string]*parsedTranslation
-	runtimeParsedTranslations map[string]*parsedTranslation
+	runtimeParsedTranslations *runtimeCache
+	runtimeCacheSize          int
+	compileConcurrency        int
+	lazyCompile               bool
+	parsers                   sync.Map // The MessageFormat parsers by locale.
+	clientPrefixes            []string
+	serverOnlyPrefixes        []string
+	lookupHooks               []func(LookupEvent)
+	fallbackHooks             []func(FallbackEvent)
+	compileErrorHooks         []func(CompileErrorEvent)
+	mustHandler               func(error)
+	pluralRules               PluralRules
+	matcherFunc               MatcherFunc
+	preferredLocales          map[string][]string
+	debug                     bool
+	missingHandlers           []func(LookupEvent)
+	missingSinks              []MissingSink
+	missingMarker             func(name, text string) string
+	hideMissingKeys           bool
+	bidiIsolation             bool
+	logger                    *slog.Logger
+	missingLimiter            *missingLimiter
+	disableRuntimeParsing     bool
+	watch                     bool
+	watcher                   *fileWatcher
+	httpClient                *http.Client
+	httpRetries               int
+	remoteMu                  sync.Mutex
+	remotes                   map[string]remoteSource
+	refreshInterval           time.Duration
+	refreshHooks              []func(RefreshEvent)
+	refresher                 *refresher
+	tenants                   map[string]map[string]map[string]*parsedTranslation
+	ignorePacks               bool
+	frozen                    bool
+	localizers                map[string]*Localizer // The shared localizers by locale, see `LocalizerFor`.
+	resolvedLocalizers        map[string]*Localizer // The shared localizers by requested locale.
 }
 
-// WithUnmarshaler replaces the default translation file unmarshaler.
+// LookupEvent describes a translation lookup made by a `Localizer`.
+type LookupEvent struct {
+	// Locale is the locale of the localizer.
+	Locale string
+	// Name is the message name without the context.
+	Name string
+	// Context is the `GetX` context, empty if none.
+	Context string
+	// Vars is the data passed to the message, nil if none.
+	Vars Vars
+	// Missing indicates that the message was not found in the locale and its fallbacks.
+	Missing bool
+	// Suggestions are the nearest existing names of a missing message, only in debug mode and in the reports
+	// of the missing handlers and the logger, see `WithDebug`.
+	Suggestions []string
+	// Suppressed is the number of identical missing reports dropped since the last one,
+	// see `WithMissingReportInterval`.
+	Suppressed int
+}
+
+// WithUnmarshaler replaces the default translation file unmarshaler, which picks JSON, YAML, TOML
+// or one of the built-in formats by the file extension, and sniffs the content of the other files.
+// The files are unmarshaled concurrently, the unmarshaler must be safe for concurrent use.
 func WithUnmarshaler(u Unmarshaler) func(*I18n) {
 	return func(bundle *I18n) {
 		bundle.unmarshaler = u
 	}
 }
 
-// WithFallback changes fallback settings.
+// WithFallback changes fallback settings: the fallbacks of each locale, looked up in order before the default locale.
+// The default locale ends the chain of the locales with explicit fallbacks too, see `FallbackChain`.
 func WithFallback(f map[string][]string) func(*I18n) {
 	return func(bundle *I18n) {
 		bundle.fallbacks = f
 	}
 }
 
+// WithLookupHook registers a hook that is called on every translation lookup,
+// useful to record the requested messages in tests. The hook must be safe for concurrent use.
+func WithLookupHook(hook func(LookupEvent)) func(*I18n) {
+	return func(bundle *I18n) {
+		bundle.lookupHooks = append(bundle.lookupHooks, hook)
+	}
+}
+
+// WithMustHandler replaces the panic of `MustGet` when a message cannot be translated,
+// e.g. with `log.Fatal` or the `Fatal` of a test.
+func WithMustHandler(handler func(error)) func(*I18n) {
+	return func(bundle *I18n) {
+		bundle.mustHandler = handler
+	}
+}
+
+// WithRuntimeParsing controls whether the names that are not found in the catalogs are parsed and cached
+// as text-based translations at runtime (enabled by default). Disable it for servers that treat the catalogs
+// as fixed at deploy time: unknown names are never compiled nor cached, they're only routed through
+// the missing translation behavior, closing the memory growth and CPU cost of arbitrary names.
+func WithRuntimeParsing(enabled bool) func(*I18n) {
+	return func(bundle *I18n) {
+		bundle.disableRuntimeParsing = !enabled
+	}
+}
+
+// WithCompileConcurrency sets the number of goroutines that compile the messages of a locale when they're loaded,
+// `GOMAXPROCS` by default. It speeds up the startup of the catalogs of tens of thousands of messages,
+// 1 compiles them serially.
+func WithCompileConcurrency(n int) func(*I18n) {
+	return func(bundle *I18n) {
+		bundle.compileConcurrency = n
+	}
+}
+
+// WithLazyCompile stores the messages raw when they're loaded and compiles them on their first use instead,
+// trading the latency of the first lookups for a faster startup, e.g. in CLIs and serverless functions.
+// The broken messages aren't reported by the loading, they're rendered as is; `Warmup` reports them.
+func WithLazyCompile(enabled bool) func(*I18n) {
+	return func(bundle *I18n) {
+		bundle.lazyCompile = enabled
+	}
+}
+
+// WithClientPrefixes limits the messages that are visible to the clients (e.g. `CatalogHandler`)
+// to the ones whose names start with one of the prefixes. All the messages are client-visible if not set.
+func WithClientPrefixes(prefixes ...string) func(*I18n) {
+	return func(bundle *I18n) {
+		bundle.clientPrefixes = append(bundle.clientPrefixes, prefixes...)
+	}
+}
+
+// WithServerOnlyPrefixes hides the messages whose names start with one of the prefixes from the clients,
+// even if they also match `WithClientPrefixes`.
+func WithServerOnlyPrefixes(prefixes ...string) func(*I18n) {
+	return func(bundle *I18n) {
+		bundle.serverOnlyPrefixes = append(bundle.serverOnlyPrefixes, prefixes...)
+	}
+}
+
 func WithDefaultLocale(locale string) func(*I18n) {
 	return func(bundle *I18n) {
 		bundle.defaultLanguage = language.Make(locale)
@@ -62,11 +196,13 @@ func WithLocales(languages ...string) func(*I18n) {
 // New creates a new internationalization.
 func NewBundle(options ...func(*I18n)) *I18n {
 	bundle := &I18n{
-		languages:                 make([]language.Tag, 0),
-		unmarshaler:               json.Unmarshal,
-		fallbacks:                 make(map[string][]string),
-		runtimeParsedTranslations: make(map[string]*parsedTranslation),
-		parsedTranslations:        make(map[string]map[string]*parsedTranslation),
+		languages:          make([]language.Tag, 0),
+		fallbacks:          make(map[string][]string),
+		runtimeCacheSize:   1000,
+		compileConcurrency: runtime.GOMAXPROCS(0),
+		parsedTranslations: make(map[string]map[string]*parsedTranslation),
+		pluralRules:        DefaultPluralRules,
+		httpRetries:        2,
 	}
 	for _, o := range options {
 		o(bundle)
@@ -86,14 +222,83 @@ func NewBundle(options ...func(*I18n)) *I18n {
 	} else if len(bundle.languages) == 0 {
 		bundle.languages = append(bundle.languages, bundle.defaultLanguage)
 	}
-	bundle.languageMatcher = language.NewMatcher(bundle.languages)
+	bundle.languageMatcher = bundle.newMatcher()
+	bundle.runtimeParsedTranslations = newRuntimeCache(bundle.runtimeCacheSize)
+	bundle.loadPacks()
 	return bundle
 }
 
 func (bundle *I18n) SupportedLanguages() []language.Tag {
-	return bundle.languages
+	bundle.mu.RLock()
+	defer bundle.mu.RUnlock()
+
+	return append([]language.Tag(nil), bundle.languages...)
 }
 
+// RegisterLocale adds a locale to the supported languages at runtime, so translations can be loaded for it
+// without constructing a new bundle. Registering a supported locale again is a no-op.
+func (bundle *I18n) RegisterLocale(locale string) error {
+	if bundle.frozen {
+		return ErrFrozen
+	}
+	tag, err := language.Parse(locale)
+	if err != nil {
+		return err
+	}
+	if tag == language.Und {
+		return fmt.Errorf("%w: %q", ErrInvalidLocale, locale)
+	}
+
+	bundle.mu.Lock()
+	defer bundle.mu.Unlock()
+
+	for _, t := range bundle.languages {
+		if t == tag {
+			return nil
+		}
+	}
+	languages := make([]language.Tag, 0, len(bundle.languages)+1)
+	languages = append(languages, bundle.languages...)
+	bundle.languages = append(languages, tag)
+	bundle.languageMatcher = bundle.newMatcher()
+	bundle.resolvedLocalizers = nil
+	return nil
+}
+
+// RemoveLocale removes a locale and its translations at runtime, e.g. to free the memory of a locale that's
+// no longer served. The translations that fell back to it are resolved again, and its localizers use the default
+// locale. The default locale cannot be removed.
+func (bundle *I18n) RemoveLocale(locale string) error {
+	if bundle.frozen {
+		return ErrFrozen
+	}
+	bundle.mu.Lock()
+	defer bundle.mu.Unlock()
+
+	supported := bundle.getExactSupportedLocale(locale)
+	if supported == "" || supported == bundle.defaultLocale {
+		return fmt.Errorf("%w: %q", ErrInvalidLocale, locale)
+	}
+
+	languages := make([]language.Tag, 0, len(bundle.languages))
+	for _, t := range bundle.languages {
+		if t.String() != supported {
+			languages = append(languages, t)
+		}
+	}
+	bundle.languages = languages
+	bundle.languageMatcher = bundle.newMatcher()
+
+	bundle.clearFallbacks()
+	delete(bundle.parsedTranslations, supported)
+	for _, overrides := range bundle.tenants {
+		delete(overrides, supported)
+	}
+	bundle.formatFallbacks()
+	return nil
+}
+
+// getExactSupportedLocale returns the supported locale that exactly matches the locale, the caller must hold the lock.
 func (bundle *I18n) getExactSupportedLocale(locale string) string {
 	_, i, confidence := bundle.languageMatcher.Match(language.Make(locale))
 
@@ -108,27 +313,84 @@ func (bundle *I18n) getExactSupportedLocale(locale string) string {
 // The check is done by the bundle's matcher and therefore languages that are not returned by
 // SupportedLanguages can be supported.
 func (bundle *I18n) IsLanguageSupported(lang language.Tag) bool {
+	bundle.mu.RLock()
+	defer bundle.mu.RUnlock()
+
 	_, _, confidence := bundle.languageMatcher.Match(lang)
 	return confidence > language.No
 }
 
 // NewLocalizer reads a locale from the internationalization core.
+// The dates are formatted in the calendar of the first locale with a `ca` key, e.g. `ja-JP-u-ca-japanese`.
 func (bundle *I18n) NewLocalizer(locales ...string) *Localizer {
-	selectedLocale := bundle.defaultLocale
+	return &Localizer{
+		bundle:   bundle,
+		locale:   bundle.selectLocale(locales),
+		calendar: requestedCalendar(locales),
+	}
+}
+
+// LocalizerFor is like `NewLocalizer` for a locale but returns a localizer shared by all the callers of
+// the same resolved locale, e.g. by every request of a web server. The locales already resolved are
+// remembered until the catalogs change, so the common locales neither allocate nor match again.
+// The localizers are immutable, `Localizer.ForTenant` returns a copy.
+func (bundle *I18n) LocalizerFor(locale string) *Localizer {
+	bundle.mu.RLock()
+	localizer, ok := bundle.resolvedLocalizers[locale]
+	bundle.mu.RUnlock()
+	if ok {
+		return localizer
+	}
+
+	selected := bundle.selectLocale([]string{locale})
+	calendar := requestedCalendar([]string{locale})
+	key := selected
+	if calendar != "" {
+		key += "-u-ca-" + string(calendar)
+	}
+
+	bundle.mu.Lock()
+	defer bundle.mu.Unlock()
+
+	localizer, ok = bundle.localizers[key]
+	if !ok {
+		localizer = &Localizer{
+			bundle:   bundle,
+			locale:   selected,
+			calendar: calendar,
+		}
+		if bundle.localizers == nil {
+			bundle.localizers = make(map[string]*Localizer)
+		}
+		bundle.localizers[key] = localizer
+	}
+	// The requested locales come from the users, the number remembered is limited.
+	if len(bundle.resolvedLocalizers) < maxResolvedLocalizers {
+		if bundle.resolvedLocalizers == nil {
+			bundle.resolvedLocalizers = make(map[string]*Localizer)
+		}
+		bundle.resolvedLocalizers[locale] = localizer
+	}
+	return localizer
+}
+
+// maxResolvedLocalizers is the number of requested locales `LocalizerFor` remembers.
+const maxResolvedLocalizers = 256
+
+// selectLocale returns the first supported locale that has translations, the default locale if none.
+func (bundle *I18n) selectLocale(locales []string) string {
+	bundle.mu.RLock()
+	defer bundle.mu.RUnlock()
+
 	for _, locale := range locales {
 		locale = bundle.getExactSupportedLocale(locale)
 		if locale != "" {
 			if _, ok := bundle.parsedTranslations[locale]; ok {
-				selectedLocale = locale
-				break
+				return locale
 			}
 		}
 	}
-
-	return &Localizer{
-		bundle: bundle,
-		locale: selectedLocale,
-	}
+	return bundle.defaultLocale
 }
 
 var contextRegExp = regexp.MustCompile("<(.*?)>$")
@@ -136,9 +398,49 @@ var contextRegExp = regexp.MustCompile("<(.*?)>$")
 // parsedTranslation
 type parsedTranslation struct {
 	locale string
+	tag    language.Tag
 	name   string
 	text   string
-	format *messageformat.MessageFormat
+	// static indicates that the message has no argument nor escape, its text is rendered as is without compiling it.
+	static bool
+	// runtime indicates that the message was parsed from its name at runtime, it's missing from the catalogs.
+	runtime bool
+	// fn formats the message generated by `GenerateGo` instead of its compiled form.
+	fn MessageFunc
+
+	// The message is compiled once, on the first use.
+	once       sync.Once
+	parser     *messageformat.Parser
+	pluralFunc PluralFunc
+	format     *messageformat.MessageFormat
+	err        error
+
+	// The arguments isolated by `WithBidiIsolation` are found once, on the first use.
+	isolatedOnce sync.Once
+	isolated     []string
+}
+
+// compile compiles the message if it's not compiled yet, the static and the generated messages have no format.
+func (t *parsedTranslation) compile() (*messageformat.MessageFormat, error) {
+	if t.static || t.fn != nil {
+		return nil, nil
+	}
+	t.once.Do(func() {
+		format, err := t.parser.Parse(t.text)
+		if err != nil {
+			t.err = err
+			return
+		}
+		pluralFunc := t.pluralFunc
+		if err := format.SetPluralFunction(func(n interface{}, ordinal bool) string {
+			return pluralFunc(n, ordinal)
+		}); err != nil {
+			t.err = err
+			return
+		}
+		t.format = format
+	})
+	return t.format, t.err
 }
 
 // trimContext
@@ -146,26 +448,101 @@ func trimContext(v string) string {
 	return contextRegExp.ReplaceAllString(v, "")
 }
 
-// parseTranslation
-func (bundle *I18n) parseTranslation(locale, name, text string) (*parsedTranslation, error) {
+// splitContext splits `Post <verb>` to `Post` and `verb`.
+func splitContext(v string) (string, string) {
+	loc := contextRegExp.FindStringSubmatchIndex(v)
+	if loc == nil {
+		return v, ""
+	}
+	return strings.TrimSuffix(v[:loc[0]], " "), v[loc[2]:loc[3]]
+}
+
+// parseTranslation parses a message without compiling it, see `parsedTranslation.compile`.
+func (bundle *I18n) parseTranslation(locale string, tag language.Tag, name, text string) (*parsedTranslation, error) {
 	parsedTrans := &parsedTranslation{
 		name: name,
 	}
 	parsedTrans.locale = locale
+	parsedTrans.tag = tag
 	parsedTrans.text = text
-	base, _ := language.MustParse(locale).Base()
+	parsedTrans.static = isStaticMessage(text)
+	pluralFunc, err := bundle.pluralFunc(tag)
+	if err != nil {
+		return nil, err
+	}
+	parsedTrans.pluralFunc = pluralFunc
+	if !parsedTrans.static {
+		if parsedTrans.parser, err = bundle.messageParser(tag); err != nil {
+			return nil, err
+		}
+	}
+
+	return parsedTrans, nil
+}
 
-	langParser, err := messageformat.NewWithCulture(base.String())
+// messageParser returns the MessageFormat parser of a locale, shared by all the messages of the locale:
+// the parser is read-only once created, it's safe for concurrent use.
+func (bundle *I18n) messageParser(tag language.Tag) (*messageformat.Parser, error) {
+	if parser, ok := bundle.parsers.Load(tag); ok {
+		return parser.(*messageformat.Parser), nil
+	}
+	parser, err := newMessageParser(tag)
 	if err != nil {
 		return nil, err
 	}
+	actual, _ := bundle.parsers.LoadOrStore(tag, parser)
+	return actual.(*messageformat.Parser), nil
+}
+
+// isStaticMessage indicates whether a message is plain text: without a brace there's no argument,
+// and without a backslash there's no escape to unescape.
+func isStaticMessage(text string) bool {
+	return !strings.ContainsAny(text, "{}\\")
+}
 
-	parsedTrans.format, err = langParser.Parse(text)
+// parseMessages parses the messages of a locale, and compiles them with the workers of `WithCompileConcurrency`
+// if compile is true. The messages with a function of funcs are formatted by it, they're never compiled.
+func (bundle *I18n) parseMessages(locale string, messages map[string]string, funcs map[string]MessageFunc, compile bool) (map[string]*parsedTranslation, error) {
+	// The locale is parsed once for all the messages.
+	tag, err := language.Parse(locale)
 	if err != nil {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidLocale, locale)
+	}
+	names := make([]string, 0, len(messages))
+	for name := range messages {
+		names = append(names, name)
+	}
+	parsed := make([]*parsedTranslation, len(names))
+
+	var g errgroup.Group
+	workers := min(max(bundle.compileConcurrency, 1), len(names))
+	for w := range workers {
+		g.Go(func() error {
+			for i := w; i < len(names); i += workers {
+				trans, err := bundle.parseTranslation(locale, tag, names[i], messages[names[i]])
+				if err != nil {
+					return err
+				}
+				trans.fn = funcs[names[i]]
+				if compile {
+					if _, err := trans.compile(); err != nil {
+						return err
+					}
+				}
+				parsed[i] = trans
+			}
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
 		return nil, err
 	}
 
-	return parsedTrans, nil
+	translations := make(map[string]*parsedTranslation, len(names))
+	for i, name := range names {
+		translations[name] = parsed[i]
+	}
+	return translations, nil
 }
 
 // nameInsenstive converts `zh_CN.music.json`, `zh_CN` and `zh-TW` to `zh-CN`.
@@ -177,8 +554,26 @@ func nameInsenstive(v string) string {
 	return v
 }
 
-// formatFallbacks
+// clearFallbacks removes the translations resolved from the fallbacks, so they can be resolved again
+// by `formatFallbacks` after the catalogs change, and forgets the locales resolved by `LocalizerFor`.
+// The caller must hold the lock.
+func (bundle *I18n) clearFallbacks() {
+	bundle.resolvedLocalizers = nil
+	for locale, trans := range bundle.parsedTranslations {
+		for name, t := range trans {
+			if t.locale != locale {
+				delete(trans, name)
+			}
+		}
+	}
+}
+
+// formatFallbacks, the caller must hold the lock.
 func (bundle *I18n) formatFallbacks() {
+	chains := make(map[string][]string, len(bundle.parsedTranslations))
+	for locale := range bundle.parsedTranslations {
+		chains[locale] = bundle.fallbackChain(locale)
+	}
 	for _, grandTrans := range bundle.parsedTranslations[bundle.defaultLocale] {
 		for locale, trans := range bundle.parsedTranslations {
 			//
@@ -187,7 +582,7 @@ func (bundle *I18n) formatFallbacks() {
 			}
 			//
 			if _, ok := trans[grandTrans.name]; !ok {
-				if bestfit := bundle.lookupBestFallback(locale, grandTrans.name); bestfit != nil {
+				if bestfit := bundle.lookupBestFallback(chains[locale], grandTrans.name); bestfit != nil {
 					bundle.parsedTranslations[locale][grandTrans.name] = bestfit
 				}
 			}
@@ -195,21 +590,51 @@ func (bundle *I18n) formatFallbacks() {
 	}
 }
 
-// lookupBestFallback
-func (bundle *I18n) lookupBestFallback(locale, name string) *parsedTranslation {
-	fallbacks, ok := bundle.fallbacks[locale]
-	if !ok {
-		if v, ok := bundle.parsedTranslations[bundle.defaultLocale][name]; ok {
+// lookupBestFallback returns the translation of the first locale after the first one of the chain.
+func (bundle *I18n) lookupBestFallback(chain []string, name string) *parsedTranslation {
+	for _, fallback := range chain[1:] {
+		if v, ok := bundle.parsedTranslations[fallback][name]; ok {
 			return v
 		}
 	}
+	return nil
+}
+
+// FallbackChain returns the locales in which the messages of a locale are looked up, in order: the locale selected
+// for it like `NewLocalizer` does, its fallbacks followed by their own fallbacks recursively, then the default locale.
+// Apps can reuse the chain for their other localized resources, like the images and the templates.
+//
+// The default locale ends every chain, also after the explicit fallbacks: a message missing from all of them is
+// the message of the default locale.
+func (bundle *I18n) FallbackChain(locale string) []string {
+	selected := bundle.selectLocale([]string{locale})
+
+	bundle.mu.RLock()
+	defer bundle.mu.RUnlock()
+	return bundle.fallbackChain(selected)
+}
+
+// fallbackChain returns the chain of a supported locale, ended by the default locale even after explicit fallbacks.
+// The caller must hold the lock.
+func (bundle *I18n) fallbackChain(locale string) []string {
+	chain := bundle.appendFallbacks([]string{locale}, locale)
+	if !slices.Contains(chain, bundle.defaultLocale) {
+		chain = append(chain, bundle.defaultLocale)
+	}
+	return chain
+}
+
+// appendFallbacks appends the fallbacks of a locale and their own fallbacks depth first, skipping the locales
+// already in the chain. The locales without explicit fallbacks use their parent locales with `WithParentFallbacks`.
+func (bundle *I18n) appendFallbacks(chain []string, locale string) []string {
+	fallbacks, ok := bundle.fallbacks[locale]
+	if !ok && bundle.parentFallbacks {
+		fallbacks = bundle.parentLocales(locale)
+	}
 	for _, fallback := range fallbacks {
-		if v, ok := bundle.parsedTranslations[fallback][name]; ok {
-			return v
-		}
-		if j := bundle.lookupBestFallback(fallback, name); j != nil {
-			return j
+		if !slices.Contains(chain, fallback) {
+			chain = bundle.appendFallbacks(append(chain, fallback), fallback)
 		}
 	}
-	return nil
+	return chain
 }
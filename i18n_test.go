@@ -2,10 +2,13 @@ package i18n
 
 import (
 	"embed"
+	"fmt"
+	"sync"
 	"testing"
 
 	"github.com/pelletier/go-toml/v2"
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
 
 	"gopkg.in/yaml.v3"
 )
@@ -66,3 +69,230 @@ func TestTomlUnmarshaler(t *testing.T) {
 	localizer := bundle.NewLocalizer("zh-Hans")
 	assert.Equal("讯息 A", localizer.Get("message_a"))
 }
+
+func TestRegisterLocale(t *testing.T) {
+	assert := assert.New(t)
+
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "zh-Hans"),
+	)
+	assert.False(bundle.IsLanguageSupported(language.MustParse("pt-BR")))
+	assert.Error(bundle.RegisterLocale("not a locale"))
+
+	assert.NoError(bundle.RegisterLocale("pt-BR"))
+	assert.NoError(bundle.RegisterLocale("pt-BR"))
+	assert.Len(bundle.SupportedLanguages(), 3)
+
+	assert.NoError(bundle.LoadMessages(map[string]map[string]string{
+		"pt-BR": {
+			"hello": "Olá",
+		},
+	}))
+	localizer := bundle.NewLocalizer("pt-BR")
+	assert.Equal("pt-BR", localizer.Locale())
+	assert.Equal("Olá", localizer.Get("hello"))
+	assert.Equal("pt-BR", bundle.MatchAvailableLocale("pt-BR,pt;q=0.9"))
+}
+
+func TestRemoveLocale(t *testing.T) {
+	assert := assert.New(t)
+
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "zh-Hant", "zh-Hans"),
+		WithFallback(map[string][]string{"zh-Hans": {"zh-Hant"}}),
+	)
+	assert.NoError(bundle.LoadMessages(map[string]map[string]string{
+		"en":      {"hello": "Hello", "bye": "Bye"},
+		"zh-Hant": {"hello": "妳好", "bye": "再見"},
+		"zh-Hans": {"hello": "你好"},
+	}))
+	assert.Equal("再見", bundle.NewLocalizer("zh-Hans").Get("bye"))
+
+	assert.NoError(bundle.RemoveLocale("zh_hant"))
+	assert.Len(bundle.SupportedLanguages(), 2)
+	assert.NotEqual("zh-Hant", bundle.MatchAvailableLocale("zh-Hant"))
+	assert.Equal("en", bundle.NewLocalizer("zh-Hant").Locale())
+	assert.Equal("Bye", bundle.NewLocalizer("zh-Hans").Get("bye"))
+
+	assert.ErrorIs(bundle.RemoveLocale("en"), ErrInvalidLocale)
+	assert.ErrorIs(bundle.RemoveLocale("fr"), ErrInvalidLocale)
+
+	// A removed locale can be registered and loaded again.
+	assert.NoError(bundle.RegisterLocale("zh-Hant"))
+	assert.NoError(bundle.LoadMessages(map[string]map[string]string{"zh-Hant": {"hello": "妳好"}}))
+	assert.Equal("妳好", bundle.NewLocalizer("zh-Hant").Get("hello"))
+}
+
+func TestRegisterLocaleConcurrently(t *testing.T) {
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en"),
+	)
+	bundle.LoadMessages(map[string]map[string]string{
+		"en": {
+			"hello": "Hello, {name}",
+		},
+	})
+
+	var wg sync.WaitGroup
+	for i, locale := range []string{"de", "fr", "es", "it"} {
+		wg.Add(2)
+		go func(locale string) {
+			defer wg.Done()
+			assert.NoError(t, bundle.RegisterLocale(locale))
+			assert.NoError(t, bundle.LoadMessages(map[string]map[string]string{
+				locale: {"hello": locale + " {name}"},
+			}))
+		}(locale)
+		go func(i int) {
+			defer wg.Done()
+			bundle.NewLocalizer("de", "fr").Get("hello", Vars{"name": i})
+			bundle.NewLocalizer("en").Get("Runtime {name}", Vars{"name": i})
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Equal(t, "fr Yami", bundle.NewLocalizer("fr").Get("hello", Vars{"name": "Yami"}))
+}
+
+func TestLocalizerFor(t *testing.T) {
+	assert := assert.New(t)
+
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "zh-Hans"),
+	)
+	assert.NoError(bundle.LoadMessages(map[string]map[string]string{
+		"en":      {"hello": "Hello"},
+		"zh-Hans": {"hello": "你好"},
+	}))
+
+	localizer := bundle.LocalizerFor("zh-Hans")
+	assert.Equal("zh-Hans", localizer.Locale())
+	assert.Equal("你好", localizer.Get("hello"))
+	assert.Same(localizer, bundle.LocalizerFor("zh-hans"))
+	assert.Same(bundle.LocalizerFor("en"), bundle.LocalizerFor("fr"))
+	assert.NotSame(localizer, bundle.NewLocalizer("zh-Hans"))
+	assert.NotSame(localizer, localizer.ForTenant("acme"))
+	assert.Empty(localizer.tenant)
+
+	shared := testing.AllocsPerRun(100, func() {
+		_ = bundle.LocalizerFor("zh-Hans")
+	})
+	allocated := testing.AllocsPerRun(100, func() {
+		_ = bundle.NewLocalizer("zh-Hans")
+	})
+	assert.Zero(shared)
+	assert.Less(shared, allocated)
+
+	// The locales are resolved again when the catalogs change.
+	assert.Equal("en", bundle.LocalizerFor("ja").Locale())
+	assert.NoError(bundle.RegisterLocale("ja"))
+	assert.NoError(bundle.AddMessages("ja", map[string]string{"hello": "こんにちは"}))
+	assert.Equal("こんにちは", bundle.LocalizerFor("ja").Get("hello"))
+	assert.NoError(bundle.UnloadMessages("ja"))
+	assert.Equal("en", bundle.LocalizerFor("ja").Locale())
+}
+
+func TestCompileConcurrency(t *testing.T) {
+	assert := assert.New(t)
+
+	messages := make(map[string]string)
+	for i := 0; i < 1000; i++ {
+		messages[fmt.Sprintf("message%d", i)] = fmt.Sprintf("{count, plural, one {# file %d} other {# files %d}}", i, i)
+	}
+	for _, n := range []int{1, 4} {
+		bundle := NewBundle(
+			WithDefaultLocale("en"),
+			WithCompileConcurrency(n),
+		)
+		assert.NoError(bundle.LoadMessages(map[string]map[string]string{"en": messages}))
+		localizer := bundle.NewLocalizer("en")
+		assert.Equal("1 file 0", localizer.Get("message0", Vars{"count": 1}))
+		assert.Equal("2 files 999", localizer.Get("message999", Vars{"count": 2}))
+
+		// Nothing is loaded if a message fails to compile.
+		invalid := map[string]string{"hello": "Hello", "broken": "{count, plural, one {#}"}
+		assert.Error(bundle.LoadMessages(map[string]map[string]string{"en": invalid}))
+		assert.Equal("hello", localizer.Get("hello"))
+	}
+}
+
+func TestLazyCompile(t *testing.T) {
+	assert := assert.New(t)
+
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithLazyCompile(true),
+	)
+	assert.NoError(bundle.LoadMessages(map[string]map[string]string{
+		"en": {
+			"files":  "{count, plural, one {# file} other {# files}}",
+			"broken": "{count, plural, one {#}",
+		},
+	}))
+	files := bundle.parsedTranslations["en"]["files"]
+	assert.Nil(files.format)
+
+	localizer := bundle.NewLocalizer("en")
+	assert.Equal("2 files", localizer.Get("files", Vars{"count": 2}))
+	assert.NotNil(files.format)
+	assert.Equal("{count, plural, one {#}", localizer.Get("broken", Vars{"count": 2}))
+	assert.Error(bundle.Warmup(nil, nil))
+}
+
+func TestStaticMessages(t *testing.T) {
+	assert := assert.New(t)
+
+	bundle := NewBundle(WithDefaultLocale("en"))
+	assert.NoError(bundle.LoadMessages(map[string]map[string]string{
+		"en": {
+			"plain":   "It's 50% # off",
+			"hello":   "Hello, {name}",
+			"escaped": `Tag \#1`,
+		},
+	}))
+	translations := bundle.parsedTranslations["en"]
+	assert.True(translations["plain"].static)
+	assert.Nil(translations["plain"].format)
+	assert.False(translations["hello"].static)
+	assert.False(translations["escaped"].static)
+
+	localizer := bundle.NewLocalizer("en")
+	assert.Equal("It's 50% # off", localizer.Get("plain", Vars{"name": "Yami"}))
+	assert.Equal("Hello, Yami", localizer.Get("hello", Vars{"name": "Yami"}))
+	assert.Equal("Tag #1", localizer.Get("escaped", Vars{"name": "Yami"}))
+}
+
+func TestMessageParser(t *testing.T) {
+	assert := assert.New(t)
+
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "en-GB", "ru"),
+	)
+	assert.NoError(bundle.LoadMessages(map[string]map[string]string{
+		"en":    {"hello": "Hello, {name}", "bye": "Bye, {name}"},
+		"en-GB": {"hello": "Hiya, {name}"},
+		"ru":    {"files": "{count, plural, one {# файл} few {# файла} many {# файлов} other {# файла}}"},
+	}))
+	en := bundle.parsedTranslations["en"]
+	assert.NotNil(en["hello"].parser)
+	assert.Same(en["hello"].parser, en["bye"].parser)
+	// The date formats of the arguments differ by region.
+	assert.NotSame(en["hello"].parser, bundle.parsedTranslations["en-GB"]["hello"].parser)
+	assert.NotSame(en["hello"].parser, bundle.parsedTranslations["ru"]["files"].parser)
+	assert.Equal("5 файлов", bundle.NewLocalizer("ru").Get("files", Vars{"count": 5}))
+}
+
+func TestParseMessagesInvalidLocale(t *testing.T) {
+	assert := assert.New(t)
+
+	bundle := NewBundle(WithDefaultLocale("en"))
+	assert.NotPanics(func() {
+		_, err := bundle.parseMessages("not a locale!", map[string]string{"hello": "Hello"}, nil, true)
+		assert.ErrorIs(err, ErrInvalidLocale)
+	})
+}
@@ -0,0 +1,184 @@
+package i18n
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/goccy/go-json"
+)
+
+// ErrInvalidI18next is returned when an i18next file cannot be parsed.
+var ErrInvalidI18next = errors.New("i18n: invalid i18next file")
+
+// UnmarshalI18next is an `Unmarshaler` for the i18next JSON (v4) files, to be used with `WithUnmarshaler`.
+//
+// The messages are converted to ICU MessageFormat: the nested keys are joined with dots, the plural suffixes
+// (`key_one`, `key_other`, `key_ordinal_one`...) are merged into a `plural` (or `selectordinal`) argument
+// on `count` named `key`, with `key_zero` as `=0`. The interpolations (`{{name}}`) become arguments,
+// `{{count}}` becomes `#` in the plurals, and the nestings (`$t(key)`) are inlined.
+func UnmarshalI18next(data []byte, v any) error {
+	out, ok := v.(*map[string]string)
+	if !ok {
+		return fmt.Errorf("%w: unsupported target %T", ErrInvalidI18next, v)
+	}
+	if *out == nil {
+		*out = make(map[string]string)
+	}
+
+	var nested map[string]any
+	if err := json.Unmarshal(data, &nested); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidI18next, err)
+	}
+	raw := make(map[string]string)
+	flattenMessages(raw, "", nested)
+
+	r := &i18nextResource{
+		messages: make(map[string]string),
+		plurals:  make(map[string]map[string]string),
+		ordinals: make(map[string]map[string]string),
+	}
+	for key, text := range raw {
+		r.add(key, text)
+	}
+	for name := range r.messages {
+		(*out)[name] = r.render(name, 0)
+	}
+	for name := range r.plurals {
+		(*out)[name] = r.render(name, 0)
+	}
+	for name := range r.ordinals {
+		if _, ok := r.plurals[name]; !ok {
+			(*out)[name] = r.render(name, 0)
+		}
+	}
+	return nil
+}
+
+// i18nextResource is a parsed i18next file, the plural forms are grouped by their key.
+type i18nextResource struct {
+	messages map[string]string
+	plurals  map[string]map[string]string
+	ordinals map[string]map[string]string
+}
+
+// add adds a flattened key, the plural suffixes are grouped.
+func (r *i18nextResource) add(key, text string) {
+	i := strings.LastIndexByte(key, '_')
+	if i > 0 && isPluralCategory(key[i+1:]) {
+		name, category := key[:i], key[i+1:]
+		group := r.plurals
+		if strings.HasSuffix(name, "_ordinal") {
+			name, group = strings.TrimSuffix(name, "_ordinal"), r.ordinals
+		}
+		if _, ok := group[name]; !ok {
+			group[name] = make(map[string]string)
+		}
+		group[name][category] = text
+		return
+	}
+	r.messages[key] = text
+}
+
+// render converts a message to ICU MessageFormat, the nestings are inlined.
+func (r *i18nextResource) render(name string, depth int) string {
+	if depth > 16 {
+		// A nesting cycle.
+		return ""
+	}
+	if forms, ok := r.plurals[name]; ok {
+		return r.renderPlural("plural", forms, depth)
+	}
+	if forms, ok := r.ordinals[name]; ok {
+		return r.renderPlural("selectordinal", forms, depth)
+	}
+	return r.renderText(r.messages[name], false, depth)
+}
+
+// renderPlural converts the plural forms to a `plural` or `selectordinal` argument on `count`.
+func (r *i18nextResource) renderPlural(typ string, forms map[string]string, depth int) string {
+	var b strings.Builder
+	b.WriteString("{count, " + typ + ",")
+	if text, ok := forms["zero"]; ok {
+		b.WriteString(" =0 {" + r.renderText(text, true, depth) + "}")
+	}
+	for _, category := range cldrCategoryOrder {
+		text, ok := forms[category]
+		if !ok || category == "zero" {
+			continue
+		}
+		b.WriteString(" " + category + " {" + r.renderText(text, true, depth) + "}")
+	}
+	if _, ok := forms["other"]; !ok {
+		// ICU requires `other`, use the last form like i18next does with a missing form.
+		for i := len(cldrCategoryOrder) - 1; i >= 0; i-- {
+			if text, ok := forms[cldrCategoryOrder[i]]; ok {
+				b.WriteString(" other {" + r.renderText(text, true, depth) + "}")
+				break
+			}
+		}
+	}
+	b.WriteString("}")
+	return b.String()
+}
+
+// renderText converts the interpolations and the nestings of a text, `{{count}}` becomes `#` in a plural.
+func (r *i18nextResource) renderText(text string, plural bool, depth int) string {
+	var b strings.Builder
+	for text != "" {
+		interpolation := strings.Index(text, "{{")
+		nesting := strings.Index(text, "$t(")
+		switch {
+		case interpolation >= 0 && (nesting < 0 || interpolation < nesting):
+			end := strings.Index(text[interpolation:], "}}")
+			if end < 0 {
+				b.WriteString(escapeICU(text))
+				return b.String()
+			}
+			b.WriteString(escapeICU(text[:interpolation]))
+			name := i18nextVariable(text[interpolation+2 : interpolation+end])
+			if plural && name == "count" {
+				b.WriteString("#")
+			} else {
+				b.WriteString("{" + name + "}")
+			}
+			text = text[interpolation+end+2:]
+		case nesting >= 0:
+			end := strings.IndexByte(text[nesting:], ')')
+			if end < 0 {
+				b.WriteString(escapeICU(text))
+				return b.String()
+			}
+			b.WriteString(escapeICU(text[:nesting]))
+			// The options of `$t(key, {"count": 2})` are ignored, the variables are passed through.
+			key, _, _ := strings.Cut(text[nesting+3:nesting+end], ",")
+			key = strings.TrimSpace(key)
+			if r.exists(key) {
+				b.WriteString(r.render(key, depth+1))
+			} else {
+				b.WriteString(escapeICU(text[nesting : nesting+end+1]))
+			}
+			text = text[nesting+end+1:]
+		default:
+			b.WriteString(escapeICU(text))
+			text = ""
+		}
+	}
+	return b.String()
+}
+
+// exists reports whether a nested key is in the file.
+func (r *i18nextResource) exists(key string) bool {
+	_, message := r.messages[key]
+	_, plural := r.plurals[key]
+	_, ordinal := r.ordinals[key]
+	return message || plural || ordinal
+}
+
+// i18nextVariable returns the variable of an interpolation like `{{- name, uppercase}}`.
+func i18nextVariable(s string) string {
+	s = strings.TrimSpace(s)
+	s = strings.TrimSpace(strings.TrimPrefix(s, "-"))
+	name, _, _ := strings.Cut(s, ",")
+	return strings.TrimSpace(name)
+}
@@ -0,0 +1,44 @@
+package i18n
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnmarshalI18next(t *testing.T) {
+	assert := assert.New(t)
+
+	b, err := os.ReadFile("test/en.i18next.json")
+	assert.NoError(err)
+	var messages map[string]string
+	assert.NoError(UnmarshalI18next(b, &messages))
+	assert.Equal(map[string]string{
+		"welcome":        "Welcome, {name}!",
+		"brand":          "Acme",
+		"about":          "About Acme",
+		"inbox.title":    "Inbox of {user}",
+		"inbox.messages": "{count, plural, =0 {No messages} one {# message} other {# messages}}",
+		"inbox.summary":  "Inbox of {user}: {count, plural, =0 {No messages} one {# message} other {# messages}}",
+		"place":          "{count, selectordinal, one {#st} two {#nd} few {#rd} other {#th}}",
+		"price":          "{amount} \\#1 \\{sale\\}",
+		"unknown":        "See $t(missing)",
+	}, messages)
+
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithUnmarshaler(UnmarshalI18next),
+	)
+	assert.NoError(bundle.LoadFiles("test/en.i18next.json"))
+	localizer := bundle.NewLocalizer("en")
+	assert.Equal("Welcome, Yami!", localizer.Get("welcome", Vars{"name": "Yami"}))
+	assert.Equal("No messages", localizer.Get("inbox.messages", Vars{"count": 0}))
+	assert.Equal("1 message", localizer.Get("inbox.messages", Vars{"count": 1}))
+	assert.Equal("Inbox of Yami: 5 messages", localizer.Get("inbox.summary", Vars{"user": "Yami", "count": 5}))
+	assert.Equal("22nd", localizer.Get("place", Vars{"count": 22}))
+	assert.Equal("9.99 #1 {sale}", localizer.Get("price", Vars{"amount": "9.99"}))
+
+	assert.True(errors.Is(UnmarshalI18next([]byte("{"), &messages), ErrInvalidI18next))
+}
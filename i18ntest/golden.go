@@ -0,0 +1,110 @@
+package i18ntest
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/goccy/go-json"
+	"github.com/kaptinlin/go-i18n"
+)
+
+// UpdateGoldenEnv is the environment variable that makes `AssertGolden` rewrite the golden files.
+const UpdateGoldenEnv = "I18N_UPDATE_GOLDEN"
+
+// GoldenCase is a message rendered with a set of sample vars in the golden files.
+type GoldenCase struct {
+	Name string
+	// Vars are the samples the message is rendered with, the message is rendered without vars if empty.
+	Vars []i18n.Vars
+}
+
+// AssertGolden renders the cases in the locales (all the supported languages if empty)
+// and compares the output with the golden file, catching unintended changes of the plural rules
+// or the formatting when the dependencies or the catalogs change.
+//
+// Run the tests with `I18N_UPDATE_GOLDEN=1` to write the golden file.
+func AssertGolden(t testing.TB, bundle *i18n.I18n, path string, locales []string, cases []GoldenCase) bool {
+	t.Helper()
+
+	if len(locales) == 0 {
+		for _, tag := range bundle.SupportedLanguages() {
+			locales = append(locales, tag.String())
+		}
+	}
+	got := renderGolden(bundle, locales, cases)
+
+	if os.Getenv(UpdateGoldenEnv) != "" {
+		if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+			t.Fatalf("i18ntest: %v", err)
+		}
+		if err := os.WriteFile(path, got, 0o600); err != nil {
+			t.Fatalf("i18ntest: %v", err)
+		}
+		return true
+	}
+
+	want, err := os.ReadFile(path) //nolint:gosec
+	if errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("i18ntest: golden file %s does not exist, run the tests with %s=1 to create it", path, UpdateGoldenEnv)
+		return false
+	} else if err != nil {
+		t.Fatalf("i18ntest: %v", err)
+	}
+	if diff := diffLines(string(want), string(got)); diff != "" {
+		t.Errorf("i18ntest: rendered messages differ from %s (run the tests with %s=1 to update it):\n%s", path, UpdateGoldenEnv, diff)
+		return false
+	}
+	return true
+}
+
+// renderGolden renders every case, a block per sample:
+//
+//	== en apples {"count":1}
+//	1 apple
+func renderGolden(bundle *i18n.I18n, locales []string, cases []GoldenCase) []byte {
+	var buf bytes.Buffer
+	for _, locale := range locales {
+		localizer := bundle.NewLocalizer(locale)
+		for _, c := range cases {
+			if len(c.Vars) == 0 {
+				fmt.Fprintf(&buf, "== %s %s\n%s\n", localizer.Locale(), c.Name, localizer.Get(c.Name))
+				continue
+			}
+			for _, vars := range c.Vars {
+				b, _ := json.Marshal(vars)
+				fmt.Fprintf(&buf, "== %s %s %s\n%s\n", localizer.Locale(), c.Name, b, localizer.Get(c.Name, vars))
+			}
+		}
+	}
+	return buf.Bytes()
+}
+
+// diffLines returns the lines that differ between want and got, empty if equal.
+func diffLines(want, got string) string {
+	if want == got {
+		return ""
+	}
+	wantLines := strings.Split(want, "\n")
+	gotLines := strings.Split(got, "\n")
+
+	var diff strings.Builder
+	for i := 0; i < len(wantLines) || i < len(gotLines); i++ {
+		var w, g string
+		if i < len(wantLines) {
+			w = wantLines[i]
+		}
+		if i < len(gotLines) {
+			g = gotLines[i]
+		}
+		if w != g {
+			fmt.Fprintf(&diff, "line %d:\n\t- %s\n\t+ %s\n", i+1, w, g)
+		}
+	}
+	return diff.String()
+}
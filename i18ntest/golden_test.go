@@ -0,0 +1,59 @@
+package i18ntest
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kaptinlin/go-i18n"
+	"github.com/stretchr/testify/assert"
+)
+
+var testGoldenCases = []GoldenCase{
+	{Name: "hello", Vars: []i18n.Vars{{"name": "Yami"}}},
+	{Name: "apples", Vars: []i18n.Vars{{"count": 1}, {"count": 2}}},
+}
+
+func TestAssertGolden(t *testing.T) {
+	assert := assert.New(t)
+	path := filepath.Join(t.TempDir(), "messages.golden")
+	bundle := NewBundle(t, "en", testMessages)
+
+	ft := &fakeT{TB: t}
+	assert.False(AssertGolden(ft, bundle, path, []string{"en", "de"}, testGoldenCases))
+	assert.Contains(ft.errors[0], "does not exist")
+
+	t.Setenv(UpdateGoldenEnv, "1")
+	assert.True(AssertGolden(t, bundle, path, []string{"en", "de"}, testGoldenCases))
+	b, err := os.ReadFile(path)
+	assert.NoError(err)
+	assert.Equal(`== en hello {"name":"Yami"}
+Hello, Yami
+== en apples {"count":1}
+1 apple
+== en apples {"count":2}
+2 apples
+== de hello {"name":"Yami"}
+Hallo, Yami
+== de apples {"count":1}
+1 apple
+== de apples {"count":2}
+2 apples
+`, string(b))
+
+	t.Setenv(UpdateGoldenEnv, "")
+	assert.True(AssertGolden(t, bundle, path, []string{"en", "de"}, testGoldenCases))
+
+	changed := NewBundle(t, "en", map[string]map[string]string{
+		"en": {
+			"hello":  "Hello, {name}",
+			"apples": "{count, plural, other {# apples}}",
+		},
+		"de": {
+			"hello": "Hallo, {name}",
+		},
+	})
+	ft = &fakeT{TB: t}
+	assert.False(AssertGolden(ft, changed, path, []string{"en", "de"}, testGoldenCases))
+	assert.Contains(ft.errors[0], "line 4:\n\t- 1 apple\n\t+ 1 apples\n")
+}
@@ -0,0 +1,83 @@
+// Package i18ntest provides helpers to enforce the translation quality in tests.
+package i18ntest
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/kaptinlin/go-i18n"
+)
+
+// NewBundle builds a bundle from the inline messages, every locale of the messages is supported.
+func NewBundle(t testing.TB, defaultLocale string, messages map[string]map[string]string, options ...func(*i18n.I18n)) *i18n.I18n {
+	t.Helper()
+
+	locales := make([]string, 0, len(messages))
+	for locale := range messages {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+
+	options = append([]func(*i18n.I18n){
+		i18n.WithDefaultLocale(defaultLocale),
+		i18n.WithLocales(locales...),
+	}, options...)
+	bundle := i18n.NewBundle(options...)
+	if err := bundle.LoadMessages(messages); err != nil {
+		t.Fatalf("i18ntest: failed to load the messages: %v", err)
+	}
+	return bundle
+}
+
+// RequireAllKeysTranslated fails the test immediately if any message of the default locale
+// is not translated in the locale.
+func RequireAllKeysTranslated(t testing.TB, bundle *i18n.I18n, locale string) {
+	t.Helper()
+
+	if missing := bundle.MissingTranslations(locale); len(missing) > 0 {
+		t.Fatalf("i18ntest: %d message(s) not translated in %q: %s", len(missing), locale, strings.Join(missing, ", "))
+	}
+}
+
+// RequireCoverage fails the test immediately if the translation coverage of a locale
+// is below its threshold (0 to 1), see `i18n.I18n.Coverage`.
+//
+//	i18ntest.RequireCoverage(t, bundle, map[string]float64{"fr": 0.95})
+func RequireCoverage(t testing.TB, bundle *i18n.I18n, thresholds map[string]float64) {
+	t.Helper()
+
+	locales := make([]string, 0, len(thresholds))
+	for locale := range thresholds {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+
+	var failures []string
+	for _, locale := range locales {
+		if coverage := bundle.Coverage(locale); coverage < thresholds[locale] {
+			failures = append(failures, fmt.Sprintf("%s %.2f%% < %.2f%%", locale, coverage*100, thresholds[locale]*100))
+		}
+	}
+	if len(failures) > 0 {
+		t.Fatalf("i18ntest: translation coverage below the threshold: %s", strings.Join(failures, ", "))
+	}
+}
+
+// AssertRenders checks that the localizer renders the message with the vars as expected.
+func AssertRenders(t testing.TB, localizer *i18n.Localizer, name string, vars i18n.Vars, want string) bool {
+	t.Helper()
+
+	var got string
+	if vars == nil {
+		got = localizer.Get(name)
+	} else {
+		got = localizer.Get(name, vars)
+	}
+	if got != want {
+		t.Errorf("i18ntest: %q in %q rendered %q, want %q", name, localizer.Locale(), got, want)
+		return false
+	}
+	return true
+}
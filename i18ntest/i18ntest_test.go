@@ -0,0 +1,95 @@
+package i18ntest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kaptinlin/go-i18n"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeT records the failures instead of failing the test.
+type fakeT struct {
+	testing.TB
+	errors []string
+	fatal  bool
+}
+
+func (t *fakeT) Helper() {}
+
+func (t *fakeT) Errorf(format string, args ...any) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+
+func (t *fakeT) Fatalf(format string, args ...any) {
+	t.Errorf(format, args...)
+	t.fatal = true
+}
+
+var testMessages = map[string]map[string]string{
+	"en": {
+		"hello":  "Hello, {name}",
+		"apples": "{count, plural, one {# apple} other {# apples}}",
+	},
+	"zh-Hans": {
+		"hello":  "你好，{name}",
+		"apples": "{count, plural, other {# 个苹果}}",
+	},
+	"de": {
+		"hello": "Hallo, {name}",
+	},
+}
+
+func TestNewBundle(t *testing.T) {
+	assert := assert.New(t)
+	bundle := NewBundle(t, "en", testMessages)
+
+	assert.Equal("en", bundle.SupportedLanguages()[0].String())
+	assert.Len(bundle.SupportedLanguages(), 3)
+	assert.Equal("Hallo, Yami", bundle.NewLocalizer("de").Get("hello", i18n.Vars{"name": "Yami"}))
+}
+
+func TestNewBundleInvalidMessage(t *testing.T) {
+	ft := &fakeT{TB: t}
+	NewBundle(ft, "en", map[string]map[string]string{"en": {"broken": "{count, plural,"}})
+
+	assert.True(t, ft.fatal)
+}
+
+func TestRequireAllKeysTranslated(t *testing.T) {
+	assert := assert.New(t)
+	bundle := NewBundle(t, "en", testMessages)
+
+	ft := &fakeT{TB: t}
+	RequireAllKeysTranslated(ft, bundle, "zh-Hans")
+	assert.False(ft.fatal)
+
+	ft = &fakeT{TB: t}
+	RequireAllKeysTranslated(ft, bundle, "de")
+	assert.True(ft.fatal)
+	assert.Contains(ft.errors[0], "apples")
+}
+
+func TestAssertRenders(t *testing.T) {
+	assert := assert.New(t)
+	localizer := NewBundle(t, "en", testMessages).NewLocalizer("en")
+
+	ft := &fakeT{TB: t}
+	assert.True(AssertRenders(ft, localizer, "apples", i18n.Vars{"count": 2}, "2 apples"))
+	assert.False(AssertRenders(ft, localizer, "apples", i18n.Vars{"count": 1}, "1 apples"))
+	assert.Len(ft.errors, 1)
+}
+
+func TestRequireCoverage(t *testing.T) {
+	assert := assert.New(t)
+	bundle := NewBundle(t, "en", testMessages)
+
+	ft := &fakeT{TB: t}
+	RequireCoverage(ft, bundle, map[string]float64{"zh-Hans": 1, "de": 0.5})
+	assert.False(ft.fatal)
+
+	ft = &fakeT{TB: t}
+	RequireCoverage(ft, bundle, map[string]float64{"zh-Hans": 1, "de": 0.95})
+	assert.True(ft.fatal)
+	assert.Equal("i18ntest: translation coverage below the threshold: de 50.00% < 95.00%", ft.errors[0])
+}
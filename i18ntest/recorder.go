@@ -0,0 +1,84 @@
+package i18ntest
+
+import (
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/kaptinlin/go-i18n"
+)
+
+// Recorder is a lookup hook that records every message requested from a bundle,
+// so integration tests can double as catalog completeness checks.
+//
+//	recorder := i18ntest.NewRecorder()
+//	bundle := i18n.NewBundle(i18n.WithDefaultLocale("en"), recorder.Option())
+type Recorder struct {
+	mu     sync.Mutex
+	events []i18n.LookupEvent
+}
+
+// NewRecorder creates an empty recorder.
+func NewRecorder() *Recorder {
+	return &Recorder{}
+}
+
+// Option returns the bundle option that attaches the recorder.
+func (r *Recorder) Option() func(*i18n.I18n) {
+	return i18n.WithLookupHook(r.Record)
+}
+
+// Record records a lookup, it's the hook passed to `i18n.WithLookupHook`.
+func (r *Recorder) Record(event i18n.LookupEvent) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, event)
+}
+
+// Events returns the recorded lookups in order.
+func (r *Recorder) Events() []i18n.LookupEvent {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]i18n.LookupEvent(nil), r.events...)
+}
+
+// Missing returns the recorded lookups of the messages that were not found.
+func (r *Recorder) Missing() []i18n.LookupEvent {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var missing []i18n.LookupEvent
+	for _, event := range r.events {
+		if event.Missing {
+			missing = append(missing, event)
+		}
+	}
+	return missing
+}
+
+// Reset forgets the recorded lookups.
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = nil
+}
+
+// AssertNoMissing checks that no missing message was requested.
+func (r *Recorder) AssertNoMissing(t testing.TB) bool {
+	t.Helper()
+
+	missing := r.Missing()
+	if len(missing) == 0 {
+		return true
+	}
+	names := make([]string, 0, len(missing))
+	for _, event := range missing {
+		name := event.Name
+		if event.Context != "" {
+			name += " <" + event.Context + ">"
+		}
+		names = append(names, event.Locale+": "+name)
+	}
+	t.Errorf("i18ntest: %d missing message(s) requested: %s", len(missing), strings.Join(names, ", "))
+	return false
+}
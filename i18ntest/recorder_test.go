@@ -0,0 +1,31 @@
+package i18ntest
+
+import (
+	"testing"
+
+	"github.com/kaptinlin/go-i18n"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecorder(t *testing.T) {
+	assert := assert.New(t)
+	recorder := NewRecorder()
+	localizer := NewBundle(t, "en", testMessages, recorder.Option()).NewLocalizer("de")
+
+	localizer.Get("hello", i18n.Vars{"name": "Yami"})
+	localizer.GetX("Save", "button")
+
+	events := recorder.Events()
+	assert.Len(events, 2)
+	assert.Equal(i18n.LookupEvent{Locale: "de", Name: "hello", Vars: i18n.Vars{"name": "Yami"}}, events[0])
+	assert.Equal(i18n.LookupEvent{Locale: "de", Name: "Save", Context: "button", Missing: true}, events[1])
+	assert.Len(recorder.Missing(), 1)
+
+	ft := &fakeT{TB: t}
+	assert.False(recorder.AssertNoMissing(ft))
+	assert.Contains(ft.errors[0], "de: Save <button>")
+
+	recorder.Reset()
+	localizer.Get("apples", i18n.Vars{"count": 1})
+	assert.True(recorder.AssertNoMissing(t))
+}
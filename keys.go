@@ -0,0 +1,41 @@
+package i18n
+
+import (
+	"iter"
+	"slices"
+)
+
+// Keys returns an iterator over the names of the messages of a locale, including the ones resolved from
+// the fallbacks, sorted by name. Nothing is yielded if the locale is not supported.
+func (bundle *I18n) Keys(locale string) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		for name := range bundle.All(locale) {
+			if !yield(name) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over the names and the raw texts of the messages of a locale like `Messages`,
+// sorted by name. The catalog is read when the iteration starts, it can be changed while iterating.
+func (bundle *I18n) All(locale string) iter.Seq2[string, string] {
+	return func(yield func(string, string) bool) {
+		bundle.mu.RLock()
+		translations := bundle.parsedTranslations[bundle.getExactSupportedLocale(locale)]
+		names := make([]string, 0, len(translations))
+		texts := make(map[string]string, len(translations))
+		for name, trans := range translations {
+			names = append(names, name)
+			texts[name] = trans.text
+		}
+		bundle.mu.RUnlock()
+
+		slices.Sort(names)
+		for _, name := range names {
+			if !yield(name, texts[name]) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package i18n
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeys(t *testing.T) {
+	assert := assert.New(t)
+
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "zh-Hans"),
+	)
+	assert.NoError(bundle.LoadMessages(map[string]map[string]string{
+		"en":      {"greeting": "Hello", "bye": "Bye"},
+		"zh-Hans": {"greeting": "你好"},
+	}))
+
+	assert.Equal([]string{"bye", "greeting"}, slices.Collect(bundle.Keys("zh_hans")))
+	assert.Equal(map[string]string{"bye": "Bye", "greeting": "你好"}, maps.Collect(bundle.All("zh-Hans")))
+	assert.Empty(slices.Collect(bundle.Keys("fr")))
+
+	// The catalog can be changed while iterating.
+	for name := range bundle.Keys("en") {
+		assert.NoError(bundle.UnloadMessages("en", name))
+	}
+	assert.Empty(bundle.Messages("en"))
+}
@@ -0,0 +1,75 @@
+package i18n
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// ListType is the conjunction of a list, `and` or `or`.
+type ListType string
+
+// The list types of CLDR.
+const (
+	ListAnd ListType = "and"
+	ListOr  ListType = "or"
+)
+
+// index returns the index of the type in `localeData.lists`, the one of `ListAnd` if unknown.
+func (typ ListType) index() int {
+	if typ == ListOr {
+		return 1
+	}
+	return 0
+}
+
+// FormatList joins items with the CLDR list patterns of the locale,
+// e.g. `a, b, and c` in `en` and `a、b和c` in `zh` for `ListAnd`.
+func (localizer *Localizer) FormatList(items []string, typ ListType) string {
+	return formatList(localizer.tag(), items, typ)
+}
+
+// formatList joins items with the start, middle and end patterns of a list type of the locale.
+func formatList(tag language.Tag, items []string, typ ListType) string {
+	patterns := lookupLocaleData(tag).lists[typ.index()]
+	switch len(items) {
+	case 0:
+		return ""
+	case 1:
+		return items[0]
+	case 2:
+		return applyListPattern(patterns[0], items[0], items[1])
+	}
+	s := applyListPattern(patterns[3], items[len(items)-2], items[len(items)-1])
+	for i := len(items) - 3; i > 0; i-- {
+		s = applyListPattern(patterns[2], items[i], s)
+	}
+	return applyListPattern(patterns[1], items[0], s)
+}
+
+// applyListPattern replaces the `{0}` and `{1}` placeholders of a list pattern in a single pass,
+// so that the braces of the items are kept.
+func applyListPattern(pattern, first, rest string) string {
+	return strings.NewReplacer("{0}", first, "{1}", rest).Replace(pattern)
+}
+
+// formatListArgument formats the value of a `{items, list}` argument, with the `and` and `or` styles.
+func formatListArgument(tag language.Tag, value any, style string) (string, error) {
+	if style != "" && style != string(ListAnd) && style != string(ListOr) {
+		return "", fmt.Errorf("invalid list style: %s", style)
+	}
+	var items []string
+	switch v := value.(type) {
+	case []string:
+		items = v
+	case []any:
+		items = make([]string, len(v))
+		for i, item := range v {
+			items[i] = fmt.Sprint(item)
+		}
+	default:
+		return "", fmt.Errorf("invalid list: %T", value)
+	}
+	return formatList(tag, items, ListType(style)), nil
+}
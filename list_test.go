@@ -0,0 +1,51 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatList(t *testing.T) {
+	assert := assert.New(t)
+	bundle := newTestParseBundle()
+
+	cases := []struct {
+		locale string
+		items  []string
+		typ    ListType
+		want   string
+	}{
+		{"en", nil, ListAnd, ""},
+		{"en", []string{"a"}, ListAnd, "a"},
+		{"en", []string{"a", "b"}, ListAnd, "a and b"},
+		{"en", []string{"a", "b", "c"}, ListAnd, "a, b, and c"},
+		{"en", []string{"a", "b", "c", "d"}, ListOr, "a, b, c, or d"},
+		{"en-GB", []string{"a", "b", "c"}, ListAnd, "a, b and c"},
+		{"de", []string{"a", "b", "c"}, ListOr, "a, b oder c"},
+		{"ja", []string{"a", "b", "c"}, ListOr, "a、b、またはc"},
+		{"zh-Hans", []string{"a", "b", "c"}, ListAnd, "a、b和c"},
+		{"en", []string{"{1}", "{0}"}, ListAnd, "{1} and {0}"},
+	}
+	for _, c := range cases {
+		assert.Equal(c.want, bundle.NewLocalizer(c.locale).FormatList(c.items, c.typ), "%s %v", c.locale, c.items)
+	}
+}
+
+func TestListArguments(t *testing.T) {
+	assert := assert.New(t)
+
+	bundle := NewBundle(WithDefaultLocale("en"), WithLocales("en", "zh-Hans"))
+	assert.NoError(bundle.LoadMessages(map[string]map[string]string{
+		"en":      {"members": "{names, list} joined", "choice": "Pick {options, list, or}"},
+		"zh-Hans": {"members": "{names, list}加入了"},
+	}))
+	names := []string{"Alice", "Bob", "Carol"}
+
+	assert.Equal("Alice, Bob, and Carol joined", bundle.NewLocalizer("en").Get("members", Vars{"names": names}))
+	assert.Equal("Alice、Bob和Carol加入了", bundle.NewLocalizer("zh-Hans").Get("members", Vars{"names": names}))
+	assert.Equal("Pick 1 or 2", bundle.NewLocalizer("en").Get("choice", Vars{"options": []any{1, 2}}))
+
+	_, err := bundle.NewLocalizer("en").GetE("members", Vars{"names": "Alice"})
+	assert.ErrorIs(err, ErrFormatMessage)
+}
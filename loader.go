@@ -1,57 +1,240 @@
 package i18n
 
 import (
+	"fmt"
+	"io"
 	"io/fs"
 	"os"
 	"path/filepath"
+	"runtime"
+	"slices"
+	"strconv"
+	"strings"
+
+	"golang.org/x/sync/errgroup"
 )
 
-// LoadMessages loads the translations from the map.
+// LoadMessages loads the translations from the map, the unsupported locales are ignored.
+// The messages are compiled before they're merged, nothing changes on error.
 func (bundle *I18n) LoadMessages(languages map[string]map[string]string) error {
-	for locale, translations := range languages {
-		locale = bundle.getExactSupportedLocale(locale)
+	return bundle.loadMessages(languages, nil, !bundle.lazyCompile)
+}
 
-		if locale != "" {
-			if _, ok := bundle.parsedTranslations[locale]; !ok {
-				bundle.parsedTranslations[locale] = make(map[string]*parsedTranslation)
-			}
+// LoadGenerated loads the translations generated by `GenerateGo` like `LoadMessages`, the messages with a function
+// of funcs (by locale and name) are formatted by it: they're never parsed nor compiled at runtime.
+func (bundle *I18n) LoadGenerated(languages map[string]map[string]string, funcs map[string]map[string]MessageFunc) error {
+	return bundle.loadMessages(languages, funcs, !bundle.lazyCompile)
+}
 
-			for name, text := range translations {
-				trans, err := bundle.parseTranslation(locale, name, text)
-				if err != nil {
-					return err
-				}
-				bundle.parsedTranslations[locale][name] = trans
+// loadMessages loads the translations from the map, compiled if compile is true, see `LoadGenerated` for the funcs.
+func (bundle *I18n) loadMessages(languages map[string]map[string]string, funcs map[string]map[string]MessageFunc, compile bool) error {
+	if bundle.frozen {
+		return ErrFrozen
+	}
+
+	parsed := make(map[string][]map[string]*parsedTranslation, len(languages))
+	for requested, messages := range languages {
+		bundle.mu.RLock()
+		locale := bundle.getExactSupportedLocale(requested)
+		bundle.mu.RUnlock()
+		if locale == "" {
+			continue
+		}
+		translations, err := bundle.parseMessages(locale, messages, funcs[requested], compile)
+		if err != nil {
+			return err
+		}
+		// The locales named differently (`zh_Hans` and `zh-Hans`) are merged.
+		parsed[locale] = append(parsed[locale], translations)
+	}
+
+	bundle.mu.Lock()
+	defer bundle.mu.Unlock()
+
+	bundle.clearFallbacks()
+	defer bundle.formatFallbacks()
+
+	for locale, translations := range parsed {
+		if bundle.getExactSupportedLocale(locale) == "" {
+			// The locale was removed meanwhile.
+			continue
+		}
+		if _, ok := bundle.parsedTranslations[locale]; !ok {
+			bundle.parsedTranslations[locale] = make(map[string]*parsedTranslation)
+		}
+		for _, trans := range translations {
+			for name, t := range trans {
+				bundle.parsedTranslations[locale][name] = t
 			}
 		}
 	}
+	return nil
+}
+
+// AddMessages adds or replaces the translations of a locale at runtime (e.g. when an admin edits a string)
+// and resolves the fallbacks again. The messages are compiled before they're merged, nothing changes on error.
+func (bundle *I18n) AddMessages(locale string, messages map[string]string) error {
+	return bundle.replaceMessages(locale, messages, nil)
+}
+
+// replaceMessages adds or replaces the translations of a locale and removes the removed names at once,
+// so the lookups never see the catalog half updated.
+func (bundle *I18n) replaceMessages(locale string, messages map[string]string, removed []string) error {
+	if bundle.frozen {
+		return ErrFrozen
+	}
+	bundle.mu.RLock()
+	supported := bundle.getExactSupportedLocale(locale)
+	bundle.mu.RUnlock()
+	if supported == "" {
+		return fmt.Errorf("%w: %q", ErrInvalidLocale, locale)
+	}
+
+	translations, err := bundle.parseMessages(supported, messages, nil, !bundle.lazyCompile)
+	if err != nil {
+		return err
+	}
+
+	bundle.mu.Lock()
+	defer bundle.mu.Unlock()
+
+	bundle.clearFallbacks()
+	if _, ok := bundle.parsedTranslations[supported]; !ok {
+		bundle.parsedTranslations[supported] = make(map[string]*parsedTranslation)
+	}
+	for _, name := range removed {
+		delete(bundle.parsedTranslations[supported], name)
+	}
+	for name, trans := range translations {
+		bundle.parsedTranslations[supported][name] = trans
+	}
+	bundle.formatFallbacks()
+	return nil
+}
+
+// UnloadMessages removes the translations of the names from a locale, all of them if no name is given,
+// and resolves the fallbacks again: the names fall back like the missing translations.
+func (bundle *I18n) UnloadMessages(locale string, names ...string) error {
+	if bundle.frozen {
+		return ErrFrozen
+	}
+	bundle.mu.Lock()
+	defer bundle.mu.Unlock()
+
+	supported := bundle.getExactSupportedLocale(locale)
+	if supported == "" {
+		return fmt.Errorf("%w: %q", ErrInvalidLocale, locale)
+	}
+
+	bundle.clearFallbacks()
+	if len(names) == 0 {
+		delete(bundle.parsedTranslations, supported)
+	}
+	for _, name := range names {
+		delete(bundle.parsedTranslations[supported], name)
+	}
 	bundle.formatFallbacks()
 	return nil
 }
 
 // LoadFiles loads the translations from the files.
 func (bundle *I18n) LoadFiles(files ...string) error {
-	data := make(map[string]map[string]string)
-
+	translationFiles := make([]translationFile, 0, len(files))
 	for _, file := range files {
-		b, err := os.ReadFile(file) //nolint:gosec
-		if err != nil {
+		translationFiles = append(translationFiles, translationFile{path: file, locale: nameInsenstive(file)})
+	}
+	return bundle.loadFiles(translationFiles)
+}
+
+// LoadDirectory loads the translations from the files under the directory of each locale, like
+// `locales/en/errors.json` and `locales/zh-Hans/admin/ui.json`, the subdirectories are walked. The files directly
+// under the root are named after their locale like `LoadFiles` does. If namespace is true, the names are prefixed
+// with the path of their file, `errors.json` loads `errors.title` and `admin/ui.json` loads `admin.ui.title`.
+func (bundle *I18n) LoadDirectory(root string, namespace bool) error {
+	var files []translationFile
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
 			return err
 		}
-		var trans map[string]string
-		if err := bundle.unmarshaler(b, &trans); err != nil {
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
 			return err
 		}
-		locale := nameInsenstive(file)
-		_, ok := data[locale]
+		dir, name, nested := strings.Cut(filepath.ToSlash(rel), "/")
+		if !nested {
+			files = append(files, translationFile{path: path, locale: nameInsenstive(path)})
+			return nil
+		}
+		file := translationFile{path: path, locale: nameInsenstive(dir)}
+		if namespace {
+			file.namespace = strings.ReplaceAll(strings.TrimSuffix(name, pathExt(name)), "/", ".")
+		}
+		files = append(files, file)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return bundle.loadFiles(files)
+}
+
+// translationFile is a file of translations.
+type translationFile struct {
+	path   string
+	locale string
+	// namespace prefixes the names of the messages if not empty.
+	namespace string
+}
+
+// unmarshal unmarshals the translations of the file.
+func (file translationFile) unmarshal(bundle *I18n, data []byte) (map[string]string, error) {
+	trans, err := bundle.unmarshalMessages(file.path, data)
+	if err != nil || file.namespace == "" {
+		return trans, err
+	}
+	namespaced := make(map[string]string, len(trans))
+	for name, text := range trans {
+		namespaced[file.namespace+"."+name] = text
+	}
+	return namespaced, nil
+}
+
+// loadFiles loads the translations from the files and watches them.
+func (bundle *I18n) loadFiles(files []translationFile) error {
+	data := make(map[string]map[string]string)
+
+	results, err := unmarshalFiles(len(files), func(i int) (map[string]string, error) {
+		b, err := os.ReadFile(files[i].path) //nolint:gosec
+		if err != nil {
+			return nil, err
+		}
+		return files[i].unmarshal(bundle, b)
+	})
+	if err != nil {
+		return err
+	}
+	for i, file := range files {
+		_, ok := data[file.locale]
 		if !ok {
-			data[locale] = make(map[string]string)
+			data[file.locale] = make(map[string]string)
 		}
-		for name, text := range trans {
-			data[locale][name] = text
+		for name, text := range results[i] {
+			data[file.locale][name] = text
 		}
 	}
-	return bundle.LoadMessages(data)
+	if err := bundle.LoadMessages(data); err != nil {
+		return err
+	}
+	return bundle.watchFiles(files, results, nil)
+}
+
+// pathExt returns all the extensions of a file name like `.json` of `ui.json`.
+func pathExt(name string) string {
+	base := filepath.Base(name)
+	if i := strings.IndexByte(base, '.'); i > 0 {
+		return base[i:]
+	}
+	return ""
 }
 
 // LoadGlob loads the translations from the files that matches specified patterns.
@@ -66,31 +249,51 @@ func (bundle *I18n) LoadGlob(pattern ...string) error {
 		files = append(files, v...)
 	}
 
-	return bundle.LoadFiles(files...)
+	if err := bundle.LoadFiles(files...); err != nil {
+		return err
+	}
+	return bundle.watchFiles(nil, nil, pattern)
 }
 
 // LoadFS loads the translation from a `fs.FS`, useful for `go:embed`.
 func (bundle *I18n) LoadFS(fsys fs.FS, patterns ...string) error {
+	data, err := readFS(fsys, patterns, bundle.unmarshaler)
+	if err != nil {
+		return err
+	}
+	return bundle.LoadMessages(data)
+}
+
+// readFS reads the translations of the files matching the patterns.
+func readFS(fsys fs.FS, patterns []string, unmarshaler Unmarshaler) (map[string]map[string]string, error) {
 	var files []string
 	data := make(map[string]map[string]string)
 
 	for _, pattern := range patterns {
 		v, err := fs.Glob(fsys, pattern)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		files = append(files, v...)
 	}
 
-	for _, file := range files {
-		b, err := fs.ReadFile(fsys, file)
+	files = slices.DeleteFunc(files, func(file string) bool {
+		info, err := fs.Stat(fsys, file)
+		return err == nil && info.IsDir()
+	})
+	results, err := unmarshalFiles(len(files), func(i int) (map[string]string, error) {
+		b, err := fs.ReadFile(fsys, files[i])
 		if err != nil {
-			return err
-		}
-		trans := make(map[string]string)
-		if err := bundle.unmarshaler(b, &trans); err != nil {
-			return err
+			return nil, err
 		}
+		return unmarshalMessages(unmarshaler, files[i], b)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i, file := range files {
+		trans := results[i]
 		locale := nameInsenstive(file)
 
 		_, ok := data[locale]
@@ -101,5 +304,114 @@ func (bundle *I18n) LoadFS(fsys fs.FS, patterns ...string) error {
 			data[locale][name] = text
 		}
 	}
-	return bundle.LoadMessages(data)
+	return data, nil
+}
+
+// unmarshalFiles reads and unmarshals the files concurrently, at most `GOMAXPROCS` at once, with the function
+// of the i-th file. The translations are returned in the order of the files, so they're merged deterministically:
+// the later files override the earlier ones like a serial loading.
+func unmarshalFiles(n int, unmarshal func(i int) (map[string]string, error)) ([]map[string]string, error) {
+	results := make([]map[string]string, n)
+
+	var g errgroup.Group
+	g.SetLimit(runtime.GOMAXPROCS(0))
+	for i := range n {
+		g.Go(func() error {
+			trans, err := unmarshal(i)
+			results[i] = trans
+			return err
+		})
+	}
+	return results, g.Wait()
+}
+
+// LoadBytes loads the translations of a locale from the data of a file, for the sources that aren't files
+// like the generated content or a database. The format is detected from the content if `WithUnmarshaler` isn't set.
+func (bundle *I18n) LoadBytes(locale string, data []byte) error {
+	trans, err := bundle.unmarshalMessages("", data)
+	if err != nil {
+		return err
+	}
+	return bundle.AddMessages(locale, trans)
+}
+
+// LoadReader loads the translations of a locale from a reader, like a network response, see `LoadBytes`.
+func (bundle *I18n) LoadReader(locale string, r io.Reader) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	return bundle.LoadBytes(locale, data)
+}
+
+// unmarshalMessages unmarshals the messages of a file with the unmarshaler of the bundle.
+func (bundle *I18n) unmarshalMessages(file string, data []byte) (map[string]string, error) {
+	return unmarshalMessages(bundle.unmarshaler, file, data)
+}
+
+// unmarshalMessages unmarshals the messages of a file, with the unmarshaler detected from the file if none is set.
+// The gzip and zstd files are decompressed first.
+// The nested objects are flattened into dot-separated names like `errors.auth.invalid`, and the arrays are indexed
+// like `steps.0`.
+func unmarshalMessages(unmarshaler Unmarshaler, file string, data []byte) (map[string]string, error) {
+	file, data, err := decompress(file, data)
+	if err != nil {
+		return nil, err
+	}
+	if unmarshaler == nil {
+		unmarshaler = detectUnmarshaler(file, data)
+	}
+	var trans map[string]string
+	err = safeUnmarshal(unmarshaler, data, &trans)
+	if err == nil {
+		return trans, nil
+	}
+	var nested map[string]any
+	if nestedErr := safeUnmarshal(unmarshaler, data, &nested); nestedErr != nil {
+		// The unmarshaler doesn't support nested values, report the original error.
+		return nil, err
+	}
+	trans = make(map[string]string)
+	flattenMessages(trans, "", nested)
+	return trans, nil
+}
+
+// safeUnmarshal turns the panics of the unmarshalers into errors, the custom unmarshalers that only expect
+// a `*map[string]string` and some decoders given nested values may panic.
+func safeUnmarshal(unmarshaler Unmarshaler, data []byte, v any) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("i18n: unmarshaler panicked: %v", r)
+		}
+	}()
+	return unmarshaler(data, v)
+}
+
+// flattenMessages flattens a nested value into the messages.
+func flattenMessages(messages map[string]string, prefix string, v any) {
+	join := func(key string) string {
+		if prefix == "" {
+			return key
+		}
+		return prefix + "." + key
+	}
+	switch v := v.(type) {
+	case nil:
+	case string:
+		messages[prefix] = v
+	case map[string]any:
+		for key, value := range v {
+			flattenMessages(messages, join(key), value)
+		}
+	case map[any]any:
+		for key, value := range v {
+			flattenMessages(messages, join(fmt.Sprint(key)), value)
+		}
+	case []any:
+		for i, value := range v {
+			flattenMessages(messages, join(strconv.Itoa(i)), value)
+		}
+	default:
+		messages[prefix] = fmt.Sprint(v)
+	}
 }
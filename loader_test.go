@@ -1,9 +1,16 @@
 package i18n
 
 import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/goccy/go-json"
+	"github.com/pelletier/go-toml/v2"
 	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
 )
 
 func TestLoadFiles(t *testing.T) {
@@ -50,3 +57,184 @@ func TestLoadFS(t *testing.T) {
 	assert.Equal("讯息 B", localizer.Get("message_b"))
 	assert.Equal("讯息 C", localizer.Get("message_c"))
 }
+
+func TestLoadFilesOrder(t *testing.T) {
+	assert := assert.New(t)
+
+	// The files are read concurrently, the later ones still override the earlier ones.
+	dir := t.TempDir()
+	var files []string
+	for i := 0; i < 64; i++ {
+		file := filepath.Join(dir, fmt.Sprintf("%02d", i), "en.json")
+		assert.NoError(os.MkdirAll(filepath.Dir(file), 0o755))
+		assert.NoError(os.WriteFile(file, []byte(fmt.Sprintf(`{"hello": "Hello %d", "file%d": "%d"}`, i, i, i)), 0o600))
+		files = append(files, file)
+	}
+	bundle := NewBundle(WithDefaultLocale("en"))
+	assert.NoError(bundle.LoadFiles(files...))
+
+	localizer := bundle.NewLocalizer("en")
+	assert.Equal("Hello 63", localizer.Get("hello"))
+	assert.Equal("0", localizer.Get("file0"))
+	assert.Equal("63", localizer.Get("file63"))
+
+	assert.NoError(os.WriteFile(files[10], []byte(`{"hello": `), 0o600))
+	assert.Error(bundle.LoadFiles(files...))
+}
+
+func TestAddMessages(t *testing.T) {
+	assert := assert.New(t)
+
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "zh-Hans"),
+	)
+	assert.NoError(bundle.LoadMessages(map[string]map[string]string{
+		"en":      {"hello": "Hello", "bye": "Bye"},
+		"zh-Hans": {"hello": "你好"},
+	}))
+
+	assert.NoError(bundle.AddMessages("en", map[string]string{"bye": "Goodbye", "welcome": "Welcome, {name}"}))
+	localizer := bundle.NewLocalizer("zh-Hans")
+	assert.Equal("Goodbye", localizer.Get("bye"))
+	assert.Equal("Welcome, Yami", localizer.Get("welcome", Vars{"name": "Yami"}))
+
+	assert.NoError(bundle.AddMessages("zh_hans", map[string]string{"bye": "再见"}))
+	assert.Equal("再见", localizer.Get("bye"))
+	assert.Equal("Goodbye", bundle.NewLocalizer("en").Get("bye"))
+
+	assert.ErrorIs(bundle.AddMessages("fr", map[string]string{"bye": "Au revoir"}), ErrInvalidLocale)
+	assert.Error(bundle.AddMessages("zh-Hans", map[string]string{"hello": "你好", "broken": "{count, plural, one {#}}"}))
+	assert.Equal("broken", localizer.Get("broken"))
+}
+
+func TestUnloadMessages(t *testing.T) {
+	assert := assert.New(t)
+
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "zh-Hans"),
+	)
+	assert.NoError(bundle.LoadMessages(map[string]map[string]string{
+		"en":      {"hello": "Hello", "bye": "Bye"},
+		"zh-Hans": {"hello": "你好", "bye": "再见"},
+	}))
+
+	assert.NoError(bundle.UnloadMessages("zh-Hans", "bye"))
+	localizer := bundle.NewLocalizer("zh-Hans")
+	assert.Equal("你好", localizer.Get("hello"))
+	assert.Equal("Bye", localizer.Get("bye"))
+
+	assert.NoError(bundle.UnloadMessages("en", "bye"))
+	assert.Equal("bye", localizer.Get("bye"))
+
+	assert.NoError(bundle.UnloadMessages("zh-Hans"))
+	assert.Equal("en", bundle.NewLocalizer("zh-Hans").Locale())
+	assert.ErrorIs(bundle.UnloadMessages("fr", "hello"), ErrInvalidLocale)
+}
+
+func TestLoadBytes(t *testing.T) {
+	assert := assert.New(t)
+
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "zh-Hans"),
+	)
+	assert.NoError(bundle.LoadBytes("en", []byte(`{"hello": "Hello", "nav": {"home": "Home"}}`)))
+	assert.NoError(bundle.LoadReader("zh-Hans", strings.NewReader("hello: 你好")))
+
+	assert.Equal("Home", bundle.NewLocalizer("en").Get("nav.home"))
+	localizer := bundle.NewLocalizer("zh-Hans")
+	assert.Equal("你好", localizer.Get("hello"))
+	assert.Equal("Home", localizer.Get("nav.home"))
+
+	assert.ErrorIs(bundle.LoadBytes("fr", []byte(`{"hello": "Bonjour"}`)), ErrInvalidLocale)
+	assert.Error(bundle.LoadBytes("en", []byte(`{"hello": `)))
+}
+
+func TestLoadDirectory(t *testing.T) {
+	assert := assert.New(t)
+
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "zh-Hans"),
+	)
+	assert.NoError(bundle.LoadDirectory("test/locales", true))
+	localizer := bundle.NewLocalizer("en")
+	assert.Equal("Hello", localizer.Get("hello"))
+	assert.Equal("Error", localizer.Get("errors.title"))
+	assert.Equal("Invalid password", localizer.Get("errors.auth.invalid"))
+	assert.Equal("Admin", localizer.Get("ui.title"))
+	assert.Equal("管理", bundle.NewLocalizer("zh-Hans").Get("admin.ui.title"))
+
+	bundle = NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "zh-Hans"),
+	)
+	assert.NoError(bundle.LoadDirectory("test/locales", false))
+	assert.Equal("Invalid password", bundle.NewLocalizer("en").Get("auth.invalid"))
+	assert.Equal("管理", bundle.NewLocalizer("zh-Hans").Get("title"))
+
+	assert.Error(bundle.LoadDirectory("test/missing", false))
+}
+
+func TestLoadNestedFiles(t *testing.T) {
+	assert := assert.New(t)
+
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "zh-Hans"),
+	)
+	assert.NoError(bundle.LoadFiles("test/nested/en.json"))
+	assert.Equal(map[string]string{
+		"hello":               "Hello",
+		"errors.auth.invalid": "Invalid credentials",
+		"errors.auth.locked":  "Account locked for {minutes} minutes",
+		"errors.code":         "404",
+		"steps.0":             "Sign up",
+		"steps.1":             "Verify your email",
+		"enabled":             "true",
+	}, bundle.Messages("en"))
+
+	localizer := bundle.NewLocalizer("en")
+	assert.Equal("Account locked for 5 minutes", localizer.Get("errors.auth.locked", Vars{"minutes": 5}))
+
+	bundle = NewBundle(
+		WithDefaultLocale("zh-Hans"),
+		WithUnmarshaler(yaml.Unmarshal),
+	)
+	assert.NoError(bundle.LoadFiles("test/nested/zh-Hans.yml"))
+	assert.Equal(map[string]string{
+		"hello":               "你好",
+		"errors.auth.invalid": "凭证无效",
+		"steps.0":             "注册",
+		"steps.1":             "验证邮箱",
+	}, bundle.Messages("zh-Hans"))
+
+	bundle = NewBundle(
+		WithDefaultLocale("zh-Hans"),
+		WithUnmarshaler(toml.Unmarshal),
+	)
+	assert.NoError(bundle.LoadFS(os.DirFS("test/nested"), "*.toml"))
+	assert.Equal("凭证无效", bundle.NewLocalizer("zh-Hans").Get("errors.auth.invalid"))
+
+	// The sub-keys of an object fall back on their own.
+	bundle = NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "zh-Hans"),
+	)
+	assert.NoError(bundle.LoadFiles("test/nested/en.json", "test/nested/zh-Hans.yml"))
+	localizer = bundle.NewLocalizer("zh-Hans")
+	assert.Equal("凭证无效", localizer.Get("errors.auth.invalid"))
+	assert.Equal("Account locked for 5 minutes", localizer.Get("errors.auth.locked", Vars{"minutes": 5}))
+
+	// The unmarshalers that only support flat messages report their own errors.
+	bundle = NewBundle(
+		WithDefaultLocale("en"),
+		WithUnmarshaler(func(data []byte, v any) error {
+			m := *v.(*map[string]string)
+			return json.Unmarshal(data, &m)
+		}),
+	)
+	assert.Error(bundle.LoadFiles("test/nested/en.json"))
+}
@@ -1,6 +1,100 @@
 package i18n
 
-import "golang.org/x/text/language"
+import (
+	"slices"
+
+	"golang.org/x/text/language"
+)
+
+// MatcherFunc creates the language matcher of the supported languages,
+// the index returned by the matcher must refer to the `supported` slice.
+type MatcherFunc func(supported []language.Tag) language.Matcher
+
+// WithMatcher replaces the default language matcher, e.g. when its macro-language and script inferences
+// pick a supported locale the business considers wrong. The matcher is rebuilt when the supported languages change.
+func WithMatcher(fn MatcherFunc) func(*I18n) {
+	return func(bundle *I18n) {
+		bundle.matcherFunc = fn
+	}
+}
+
+// WithMatchOptions creates the default language matcher with the options, e.g. `language.PreferSameScript(true)`.
+func WithMatchOptions(options ...language.MatchOption) func(*I18n) {
+	return WithMatcher(func(supported []language.Tag) language.Matcher {
+		return language.NewMatcher(supported, options...)
+	})
+}
+
+// WithPreferredLocales biases the negotiation of the desired locales toward the preferred supported locales.
+// The default matcher follows the CLDR data, so `en-AU` already gets `en-GB` rather than `en-US`,
+// but `en-CA` gets `en-GB` too; override it with a preference list:
+//
+//	WithPreferredLocales(map[string][]string{"en-CA": {"en-US"}})
+//
+// The desired locales are checked in priority order, each one is either resolved by its preferences
+// or by the matcher with a high confidence before the next one is checked.
+func WithPreferredLocales(preferences map[string][]string) func(*I18n) {
+	return func(bundle *I18n) {
+		bundle.preferredLocales = preferences
+	}
+}
+
+// newMatcher creates the language matcher of the supported languages, the caller must hold the lock.
+func (bundle *I18n) newMatcher() language.Matcher {
+	var matcher language.Matcher
+	if bundle.matcherFunc != nil {
+		matcher = bundle.matcherFunc(bundle.languages)
+	} else {
+		matcher = language.NewMatcher(bundle.languages)
+	}
+	if len(bundle.preferredLocales) > 0 {
+		matcher = newPreferenceMatcher(matcher, bundle.languages, bundle.preferredLocales)
+	}
+	return matcher
+}
+
+// preferenceMatcher resolves the desired locales with the preferences before the matcher.
+type preferenceMatcher struct {
+	language.Matcher
+	supported   []language.Tag
+	preferences map[language.Tag][]int
+}
+
+// newPreferenceMatcher
+func newPreferenceMatcher(matcher language.Matcher, supported []language.Tag, preferences map[string][]string) *preferenceMatcher {
+	m := &preferenceMatcher{
+		Matcher:     matcher,
+		supported:   supported,
+		preferences: make(map[language.Tag][]int),
+	}
+	for desired, preferred := range preferences {
+		tag := language.Make(desired)
+		for _, locale := range preferred {
+			preferredTag := language.Make(locale)
+			for i, t := range supported {
+				if t == preferredTag {
+					m.preferences[tag] = append(m.preferences[tag], i)
+				}
+			}
+		}
+	}
+	return m
+}
+
+// Match
+func (m *preferenceMatcher) Match(desired ...language.Tag) (language.Tag, int, language.Confidence) {
+	for _, d := range desired {
+		base, script, region := d.Raw()
+		tag, _ := language.Compose(base, script, region)
+		if indexes, ok := m.preferences[tag]; ok {
+			return m.supported[indexes[0]], indexes[0], language.High
+		}
+		if tag, index, conf := m.Matcher.Match(d); conf >= language.High {
+			return tag, index, conf
+		}
+	}
+	return m.Matcher.Match(desired...)
+}
 
 // MatchAvailableLocale return one of the available locales
 func (bundle *I18n) MatchAvailableLocale(locales ...string) string {
@@ -14,9 +108,68 @@ func (bundle *I18n) MatchAvailableLocale(locales ...string) string {
 		tags = append(tags, desired...)
 	}
 
+	bundle.mu.RLock()
+	defer bundle.mu.RUnlock()
+
 	if _, index, conf := bundle.languageMatcher.Match(tags...); conf > language.No {
 		return bundle.languages[index].String()
 	}
 
 	return bundle.languages[0].String()
 }
+
+// CanonicalLocale normalizes a locale the way the bundle reads the names of the files, then to its BCP 47
+// canonical form, e.g. `zh_cn` and `zh_CN.UTF-8` to `zh-Hans-CN`, `iw` to `he` and `en_us` to `en-US`.
+// The likely script is added to the languages written in several scripts like `zh` and `sr`.
+func CanonicalLocale(locale string) (string, error) {
+	tag, err := language.Parse(nameInsenstive(locale))
+	if err != nil {
+		return "", err
+	}
+	if base, _ := tag.Base(); base.String() != "und" {
+		// The confidence of the script is low if it's inferred for a language with several scripts.
+		if script, confidence := tag.Script(); confidence == language.Low {
+			if tag, err = language.Compose(tag, script); err != nil {
+				return "", err
+			}
+		}
+	}
+	return tag.String(), nil
+}
+
+// WithParentFallbacks derives the fallbacks of the locales without explicit ones in `WithFallback` from their
+// CLDR parent locales, their likely script and their language, among the supported locales, e.g. `zh-TW` falls
+// back to `zh-Hant` then `zh`, and `es-MX` to `es-419` then `es`.
+func WithParentFallbacks(enabled bool) func(*I18n) {
+	return func(bundle *I18n) {
+		bundle.parentFallbacks = enabled
+	}
+}
+
+// parentLocales returns the supported locales among the CLDR parents of a locale, its language with its likely
+// script and its language alone, in this order. The caller must hold the lock.
+func (bundle *I18n) parentLocales(locale string) []string {
+	tag := language.Make(locale)
+	var candidates []language.Tag
+	for t := tag.Parent(); !t.IsRoot(); t = t.Parent() {
+		candidates = append(candidates, t)
+	}
+	base, _ := tag.Base()
+	script, _ := tag.Script()
+	if t, err := language.Compose(base, script); err == nil {
+		candidates = append(candidates, t)
+	}
+	if t, err := language.Compose(base); err == nil {
+		candidates = append(candidates, t)
+	}
+
+	var parents []string
+	for _, candidate := range candidates {
+		for _, supported := range bundle.languages {
+			if supported == candidate && supported != tag && !slices.Contains(parents, supported.String()) {
+				parents = append(parents, supported.String())
+			}
+		}
+	}
+	return parents
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
 )
 
 func TestParseAcceptLanguage(t *testing.T) {
@@ -52,3 +53,105 @@ func TestParseAcceptLanguage(t *testing.T) {
 	assert.Equal("en", localizer.Locale())
 	assert.Equal("Hello, world", localizer.Get("hello_world"))
 }
+
+// sameScriptMatcher refuses the matches that need a different script.
+type sameScriptMatcher struct {
+	language.Matcher
+	supported []language.Tag
+}
+
+func (m sameScriptMatcher) Match(t ...language.Tag) (language.Tag, int, language.Confidence) {
+	tag, index, conf := m.Matcher.Match(t...)
+	for _, desired := range t {
+		desiredScript, _ := desired.Script()
+		supportedScript, _ := m.supported[index].Script()
+		if desiredScript == supportedScript {
+			return tag, index, conf
+		}
+	}
+	return m.supported[0], 0, language.No
+}
+
+func TestWithMatcher(t *testing.T) {
+	assert := assert.New(t)
+
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "zh-Hant"),
+	)
+	assert.Equal("zh-Hant", bundle.MatchAvailableLocale("zh-CN"))
+
+	bundle = NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "zh-Hant"),
+		WithMatcher(func(supported []language.Tag) language.Matcher {
+			return sameScriptMatcher{language.NewMatcher(supported), supported}
+		}),
+	)
+	assert.Equal("en", bundle.MatchAvailableLocale("zh-CN"))
+	assert.Equal("zh-Hant", bundle.MatchAvailableLocale("zh-TW"))
+	assert.False(bundle.IsLanguageSupported(language.MustParse("zh-Hans")))
+
+	assert.NoError(bundle.RegisterLocale("zh-Hans"))
+	assert.Equal("zh-Hans", bundle.MatchAvailableLocale("zh-CN"))
+}
+
+func TestWithMatchOptions(t *testing.T) {
+	assert := assert.New(t)
+
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "sr-Latn", "sr-Cyrl"),
+		WithMatchOptions(language.PreferSameScript(true)),
+	)
+	assert.Equal("sr-Latn", bundle.MatchAvailableLocale("sr-Latn-ME"))
+}
+
+func TestWithPreferredLocales(t *testing.T) {
+	assert := assert.New(t)
+
+	bundle := NewBundle(
+		WithDefaultLocale("en-US"),
+		WithLocales("en-US", "en-GB", "fr"),
+	)
+	assert.Equal("en-GB", bundle.MatchAvailableLocale("en-AU"))
+	assert.Equal("en-GB", bundle.MatchAvailableLocale("en-CA"))
+
+	bundle = NewBundle(
+		WithDefaultLocale("en-US"),
+		WithLocales("en-US", "en-GB", "fr"),
+		WithPreferredLocales(map[string][]string{
+			"en-CA": {"en-US"},
+			"fr-CA": {"fr-CA", "fr"},
+		}),
+	)
+	assert.Equal("en-GB", bundle.MatchAvailableLocale("en-AU"))
+	assert.Equal("en-US", bundle.MatchAvailableLocale("en-CA"))
+	assert.Equal("fr", bundle.MatchAvailableLocale("fr-CA,en-CA;q=0.8"))
+	assert.Equal("en-US", bundle.MatchAvailableLocale("de,en-CA;q=0.8,fr;q=0.5"))
+}
+
+func TestCanonicalLocale(t *testing.T) {
+	assert := assert.New(t)
+
+	cases := map[string]string{
+		"zh_cn":       "zh-Hans-CN",
+		"zh_CN.UTF-8": "zh-Hans-CN",
+		"zh-tw":       "zh-Hant-TW",
+		"zh":          "zh-Hans",
+		"zh-Hant":     "zh-Hant",
+		"sr_RS":       "sr-Cyrl-RS",
+		"en_us":       "en-US",
+		"EN":          "en",
+		"iw":          "he",
+		"pt_br":       "pt-BR",
+	}
+	for input, want := range cases {
+		locale, err := CanonicalLocale(input)
+		assert.NoError(err, input)
+		assert.Equal(want, locale, input)
+	}
+
+	_, err := CanonicalLocale("not a locale")
+	assert.Error(err)
+}
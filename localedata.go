@@ -0,0 +1,491 @@
+package i18n
+
+import "golang.org/x/text/language"
+
+// localeData is the subset of the CLDR data of a locale used by the formatting and parsing helpers.
+type localeData struct {
+	// months are the wide month names in the format context, e.g. `January`.
+	months [12]string
+	// abbrMonths are the abbreviated month names in the format context, e.g. `Jan`.
+	abbrMonths [12]string
+	// dateOrder is the order of the numeric date fields, e.g. `mdy`.
+	dateOrder string
+	// dateLiterals are the words of the date patterns that are not fields, e.g. `г.` (year) in `ru`.
+	dateLiterals []string
+	// standaloneMonths are the wide and abbreviated month names in the stand-alone context where they differ
+	// from the format context, e.g. `январь` in `ru`. The empty names are the ones of the format context.
+	standaloneMonths [2][12]string
+	// narrowMonths are the narrow month names in the stand-alone context, e.g. `J`.
+	narrowMonths [12]string
+	// weekdays are the wide weekday names in the format context from Sunday, e.g. `Sunday`.
+	weekdays [7]string
+	// abbrWeekdays are the abbreviated weekday names in the format context from Sunday, e.g. `Sun`.
+	abbrWeekdays [7]string
+	// standaloneAbbrWeekdays are the abbreviated weekday names in the stand-alone context where they differ
+	// from the format context, e.g. `So` in `de`.
+	standaloneAbbrWeekdays [7]string
+	// narrowWeekdays are the narrow weekday names in the stand-alone context from Sunday, e.g. `S`.
+	narrowWeekdays [7]string
+	// dayPeriods are the abbreviated AM and PM markers, `AM` and `PM` if empty.
+	dayPeriods [2]string
+	// dateFormats are the full, long, medium and short date patterns, e.g. `MMM d, y`.
+	dateFormats [4]string
+	// timeFormats are the full, long, medium and short time patterns, e.g. `h:mm a`.
+	timeFormats [4]string
+	// dateRanges are the patterns of a single date with an abbreviated month, and of the ranges of those dates
+	// that differ by the year, the month or only the day, e.g. `MMM d–d, y`. The fields of the end date start
+	// at the first field that repeats.
+	dateRanges [4]string
+	// compactDecimals are the short compact patterns of the powers of ten from 10^3 to 10^14, e.g. `00K`,
+	// where the zeros are the integer digits and `0` alone is the number without compaction.
+	compactDecimals [12]string
+	// units are the long, short and narrow patterns of the units by their CLDR names, e.g. `hour` and `kilometer`,
+	// by plural category. The pattern of `other` is used if a category has none.
+	units map[string][3]map[string]string
+	// unitSeparators join the units of a duration in the long, short and narrow widths, e.g. `, ` in `2 hr, 30 min`.
+	unitSeparators [3]string
+	// lists are the `and` and `or` list patterns of two items, and of the start, middle and end of longer lists,
+	// e.g. `{0}, and {1}` for the end of `a, b, and c`.
+	lists [2][4]string
+	// currencies are the display names of the major currencies by their ISO 4217 codes, e.g. `US Dollar` for `USD`.
+	currencies map[string]string
+	// eras are the abbreviated names of the eras by calendar, e.g. `BC` and `AD` for the Gregorian calendar
+	// and the Japanese eras from Meiji. The names of `en` are used if a calendar has none.
+	eras map[Calendar][]string
+}
+
+// localeDataTable is keyed by the locales whose data differ from their CLDR parents.
+var localeDataTable = map[string]*localeData{
+	"en": {
+		months:          [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+		abbrMonths:      [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		dateOrder:       "mdy",
+		narrowMonths:    [12]string{"J", "F", "M", "A", "M", "J", "J", "A", "S", "O", "N", "D"},
+		weekdays:        [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		abbrWeekdays:    [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		narrowWeekdays:  [7]string{"S", "M", "T", "W", "T", "F", "S"},
+		dateFormats:     [4]string{"EEEE, MMMM d, y", "MMMM d, y", "MMM d, y", "M/d/yy"},
+		timeFormats:     [4]string{"h:mm:ss a zzzz", "h:mm:ss a z", "h:mm:ss a", "h:mm a"},
+		dateRanges:      [4]string{"MMM d, y", "MMM d, y – MMM d, y", "MMM d – MMM d, y", "MMM d–d, y"},
+		compactDecimals: [12]string{"0K", "00K", "000K", "0M", "00M", "000M", "0B", "00B", "000B", "0T", "00T", "000T"},
+		units: map[string][3]map[string]string{
+			"day":         {{"one": "{0} day", "other": "{0} days"}, {"one": "{0} day", "other": "{0} days"}, {"other": "{0}d"}},
+			"hour":        {{"one": "{0} hour", "other": "{0} hours"}, {"other": "{0} hr"}, {"other": "{0}h"}},
+			"minute":      {{"one": "{0} minute", "other": "{0} minutes"}, {"other": "{0} min"}, {"other": "{0}m"}},
+			"second":      {{"one": "{0} second", "other": "{0} seconds"}, {"other": "{0} sec"}, {"other": "{0}s"}},
+			"millisecond": {{"one": "{0} millisecond", "other": "{0} milliseconds"}, {"other": "{0} ms"}, {"other": "{0}ms"}},
+			"kilometer":   {{"one": "{0} kilometer", "other": "{0} kilometers"}, {"other": "{0} km"}, {"other": "{0}km"}},
+			"meter":       {{"one": "{0} meter", "other": "{0} meters"}, {"other": "{0} m"}, {"other": "{0}m"}},
+			"mile":        {{"one": "{0} mile", "other": "{0} miles"}, {"other": "{0} mi"}, {"other": "{0}mi"}},
+			"foot":        {{"one": "{0} foot", "other": "{0} feet"}, {"other": "{0} ft"}, {"other": "{0}′"}},
+			"kilogram":    {{"one": "{0} kilogram", "other": "{0} kilograms"}, {"other": "{0} kg"}, {"other": "{0}kg"}},
+			"pound":       {{"one": "{0} pound", "other": "{0} pounds"}, {"other": "{0} lb"}, {"other": "{0}lb"}},
+			"celsius":     {{"one": "{0} degree Celsius", "other": "{0} degrees Celsius"}, {"other": "{0}°C"}, {"other": "{0}°C"}},
+			"fahrenheit":  {{"one": "{0} degree Fahrenheit", "other": "{0} degrees Fahrenheit"}, {"other": "{0}°F"}, {"other": "{0}°"}},
+		},
+		unitSeparators: [3]string{", ", ", ", " "},
+		lists:          [2][4]string{{"{0} and {1}", "{0}, {1}", "{0}, {1}", "{0}, and {1}"}, {"{0} or {1}", "{0}, {1}", "{0}, {1}", "{0}, or {1}"}},
+		currencies:     map[string]string{"USD": "US Dollar", "EUR": "Euro", "GBP": "British Pound", "JPY": "Japanese Yen", "CNY": "Chinese Yuan"},
+		eras: map[Calendar][]string{
+			CalendarGregorian: {"BC", "AD"},
+			CalendarJapanese:  {"Meiji", "Taishō", "Shōwa", "Heisei", "Reiwa"},
+			CalendarBuddhist:  {"BE"},
+			CalendarIslamic:   {"AH"},
+		},
+	},
+	"de": {
+		months:                 [12]string{"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+		abbrMonths:             [12]string{"Jan.", "Feb.", "März", "Apr.", "Mai", "Juni", "Juli", "Aug.", "Sept.", "Okt.", "Nov.", "Dez."},
+		dateOrder:              "dmy",
+		standaloneMonths:       [2][12]string{1: {"Jan", "Feb", "Mär", "Apr", "Mai", "Jun", "Jul", "Aug", "Sep", "Okt", "Nov", "Dez"}},
+		narrowMonths:           [12]string{"J", "F", "M", "A", "M", "J", "J", "A", "S", "O", "N", "D"},
+		weekdays:               [7]string{"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"},
+		abbrWeekdays:           [7]string{"So.", "Mo.", "Di.", "Mi.", "Do.", "Fr.", "Sa."},
+		standaloneAbbrWeekdays: [7]string{"So", "Mo", "Di", "Mi", "Do", "Fr", "Sa"},
+		narrowWeekdays:         [7]string{"S", "M", "D", "M", "D", "F", "S"},
+		dateFormats:            [4]string{"EEEE, d. MMMM y", "d. MMMM y", "dd.MM.y", "dd.MM.yy"},
+		timeFormats:            [4]string{"HH:mm:ss zzzz", "HH:mm:ss z", "HH:mm:ss", "HH:mm"},
+		dateRanges:             [4]string{"d. MMM y", "d. MMM y – d. MMM y", "d. MMM – d. MMM y", "d.–d. MMM y"},
+		compactDecimals:        [12]string{"0", "0", "0", "0\u00a0Mio.", "00\u00a0Mio.", "000\u00a0Mio.", "0\u00a0Mrd.", "00\u00a0Mrd.", "000\u00a0Mrd.", "0\u00a0Bio.", "00\u00a0Bio.", "000\u00a0Bio."},
+		units: map[string][3]map[string]string{
+			"day":         {{"one": "{0} Tag", "other": "{0} Tage"}, {"other": "{0} Tg."}, {"other": "{0} T"}},
+			"hour":        {{"one": "{0} Stunde", "other": "{0} Stunden"}, {"other": "{0} Std."}, {"other": "{0} Std."}},
+			"minute":      {{"one": "{0} Minute", "other": "{0} Minuten"}, {"other": "{0} Min."}, {"other": "{0} Min."}},
+			"second":      {{"one": "{0} Sekunde", "other": "{0} Sekunden"}, {"other": "{0} Sek."}, {"other": "{0} s"}},
+			"millisecond": {{"one": "{0} Millisekunde", "other": "{0} Millisekunden"}, {"other": "{0} ms"}, {"other": "{0} ms"}},
+			"kilometer":   {{"other": "{0} Kilometer"}, {"other": "{0} km"}, {"other": "{0} km"}},
+			"meter":       {{"other": "{0} Meter"}, {"other": "{0} m"}, {"other": "{0} m"}},
+			"mile":        {{"one": "{0} Meile", "other": "{0} Meilen"}, {"other": "{0} mi"}, {"other": "{0} mi"}},
+			"foot":        {{"other": "{0} Fuß"}, {"other": "{0} ft"}, {"other": "{0} ft"}},
+			"kilogram":    {{"other": "{0} Kilogramm"}, {"other": "{0} kg"}, {"other": "{0} kg"}},
+			"pound":       {{"other": "{0} Pfund"}, {"other": "{0} lb"}, {"other": "{0} lb"}},
+			"celsius":     {{"other": "{0} Grad Celsius"}, {"other": "{0} °C"}, {"other": "{0}°C"}},
+			"fahrenheit":  {{"other": "{0} Grad Fahrenheit"}, {"other": "{0} °F"}, {"other": "{0}°F"}},
+		},
+		unitSeparators: [3]string{", ", ", ", " "},
+		lists:          [2][4]string{{"{0} und {1}", "{0}, {1}", "{0}, {1}", "{0} und {1}"}, {"{0} oder {1}", "{0}, {1}", "{0}, {1}", "{0} oder {1}"}},
+		currencies:     map[string]string{"USD": "US-Dollar", "EUR": "Euro", "GBP": "Britisches Pfund", "JPY": "Japanischer Yen", "CNY": "Renminbi Yuan"},
+		eras:           map[Calendar][]string{CalendarGregorian: {"v. Chr.", "n. Chr."}},
+	},
+	"es": {
+		months:          [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+		abbrMonths:      [12]string{"ene", "feb", "mar", "abr", "may", "jun", "jul", "ago", "sept", "oct", "nov", "dic"},
+		dateOrder:       "dmy",
+		narrowMonths:    [12]string{"E", "F", "M", "A", "M", "J", "J", "A", "S", "O", "N", "D"},
+		weekdays:        [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+		abbrWeekdays:    [7]string{"dom", "lun", "mar", "mié", "jue", "vie", "sáb"},
+		narrowWeekdays:  [7]string{"D", "L", "M", "X", "J", "V", "S"},
+		dayPeriods:      [2]string{"a. m.", "p. m."},
+		dateFormats:     [4]string{"EEEE, d 'de' MMMM 'de' y", "d 'de' MMMM 'de' y", "d MMM y", "d/M/yy"},
+		timeFormats:     [4]string{"H:mm:ss (zzzz)", "H:mm:ss z", "H:mm:ss", "H:mm"},
+		dateRanges:      [4]string{"d MMM y", "d MMM y – d MMM y", "d MMM – d MMM y", "d–d MMM y"},
+		compactDecimals: [12]string{"0\u00a0mil", "00\u00a0mil", "000\u00a0mil", "0\u00a0M", "00\u00a0M", "000\u00a0M", "0000\u00a0M", "00\u00a0mil\u00a0M", "000\u00a0mil\u00a0M", "0\u00a0B", "00\u00a0B", "000\u00a0B"},
+		units: map[string][3]map[string]string{
+			"day":         {{"one": "{0} día", "other": "{0} días"}, {"other": "{0} d"}, {"other": "{0}d"}},
+			"hour":        {{"one": "{0} hora", "other": "{0} horas"}, {"other": "{0} h"}, {"other": "{0}h"}},
+			"minute":      {{"one": "{0} minuto", "other": "{0} minutos"}, {"other": "{0} min"}, {"other": "{0}min"}},
+			"second":      {{"one": "{0} segundo", "other": "{0} segundos"}, {"other": "{0} s"}, {"other": "{0}s"}},
+			"millisecond": {{"one": "{0} milisegundo", "other": "{0} milisegundos"}, {"other": "{0} ms"}, {"other": "{0}ms"}},
+			"kilometer":   {{"one": "{0} kilómetro", "other": "{0} kilómetros"}, {"other": "{0} km"}, {"other": "{0}km"}},
+			"meter":       {{"one": "{0} metro", "other": "{0} metros"}, {"other": "{0} m"}, {"other": "{0}m"}},
+			"mile":        {{"one": "{0} milla", "other": "{0} millas"}, {"other": "{0} mi"}, {"other": "{0}mi"}},
+			"foot":        {{"one": "{0} pie", "other": "{0} pies"}, {"other": "{0} ft"}, {"other": "{0}ft"}},
+			"kilogram":    {{"one": "{0} kilogramo", "other": "{0} kilogramos"}, {"other": "{0} kg"}, {"other": "{0}kg"}},
+			"pound":       {{"one": "{0} libra", "other": "{0} libras"}, {"other": "{0} lb"}, {"other": "{0}lb"}},
+			"celsius":     {{"one": "{0} grado Celsius", "other": "{0} grados Celsius"}, {"other": "{0} °C"}, {"other": "{0}°C"}},
+			"fahrenheit":  {{"one": "{0} grado Fahrenheit", "other": "{0} grados Fahrenheit"}, {"other": "{0} °F"}, {"other": "{0}°F"}},
+		},
+		unitSeparators: [3]string{", ", ", ", " "},
+		lists:          [2][4]string{{"{0} y {1}", "{0}, {1}", "{0}, {1}", "{0} y {1}"}, {"{0} o {1}", "{0}, {1}", "{0}, {1}", "{0} o {1}"}},
+		currencies:     map[string]string{"USD": "dólar estadounidense", "EUR": "euro", "GBP": "libra esterlina", "JPY": "yen", "CNY": "yuan"},
+		eras:           map[Calendar][]string{CalendarGregorian: {"a. C.", "d. C."}},
+	},
+	"fr": {
+		months:          [12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+		abbrMonths:      [12]string{"janv.", "févr.", "mars", "avr.", "mai", "juin", "juil.", "août", "sept.", "oct.", "nov.", "déc."},
+		dateOrder:       "dmy",
+		narrowMonths:    [12]string{"J", "F", "M", "A", "M", "J", "J", "A", "S", "O", "N", "D"},
+		weekdays:        [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+		abbrWeekdays:    [7]string{"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam."},
+		narrowWeekdays:  [7]string{"D", "L", "M", "M", "J", "V", "S"},
+		dateFormats:     [4]string{"EEEE d MMMM y", "d MMMM y", "d MMM y", "dd/MM/y"},
+		timeFormats:     [4]string{"HH:mm:ss zzzz", "HH:mm:ss z", "HH:mm:ss", "HH:mm"},
+		dateRanges:      [4]string{"d MMM y", "d MMM y – d MMM y", "d MMM – d MMM y", "d–d MMM y"},
+		compactDecimals: [12]string{"0\u00a0k", "00\u00a0k", "000\u00a0k", "0\u00a0M", "00\u00a0M", "000\u00a0M", "0\u00a0Md", "00\u00a0Md", "000\u00a0Md", "0\u00a0Bn", "00\u00a0Bn", "000\u00a0Bn"},
+		units: map[string][3]map[string]string{
+			"day":         {{"one": "{0} jour", "other": "{0} jours"}, {"other": "{0}\u00a0j"}, {"other": "{0}j"}},
+			"hour":        {{"one": "{0} heure", "other": "{0} heures"}, {"other": "{0}\u00a0h"}, {"other": "{0}h"}},
+			"minute":      {{"one": "{0} minute", "other": "{0} minutes"}, {"other": "{0}\u00a0min"}, {"other": "{0}min"}},
+			"second":      {{"one": "{0} seconde", "other": "{0} secondes"}, {"other": "{0}\u00a0s"}, {"other": "{0}s"}},
+			"millisecond": {{"one": "{0} milliseconde", "other": "{0} millisecondes"}, {"other": "{0}\u00a0ms"}, {"other": "{0}ms"}},
+			"kilometer":   {{"one": "{0} kilomètre", "other": "{0} kilomètres"}, {"other": "{0}\u00a0km"}, {"other": "{0}km"}},
+			"meter":       {{"one": "{0} mètre", "other": "{0} mètres"}, {"other": "{0}\u00a0m"}, {"other": "{0}m"}},
+			"mile":        {{"one": "{0} mile", "other": "{0} miles"}, {"other": "{0}\u00a0mi"}, {"other": "{0}mi"}},
+			"foot":        {{"one": "{0} pied", "other": "{0} pieds"}, {"other": "{0}\u00a0pi"}, {"other": "{0}pi"}},
+			"kilogram":    {{"one": "{0} kilogramme", "other": "{0} kilogrammes"}, {"other": "{0}\u00a0kg"}, {"other": "{0}kg"}},
+			"pound":       {{"one": "{0} livre", "other": "{0} livres"}, {"other": "{0}\u00a0lb"}, {"other": "{0}lb"}},
+			"celsius":     {{"one": "{0} degré Celsius", "other": "{0} degrés Celsius"}, {"other": "{0}\u00a0°C"}, {"other": "{0}°C"}},
+			"fahrenheit":  {{"one": "{0} degré Fahrenheit", "other": "{0} degrés Fahrenheit"}, {"other": "{0}\u00a0°F"}, {"other": "{0}°F"}},
+		},
+		unitSeparators: [3]string{" ", " ", " "},
+		lists:          [2][4]string{{"{0} et {1}", "{0}, {1}", "{0}, {1}", "{0} et {1}"}, {"{0} ou {1}", "{0}, {1}", "{0}, {1}", "{0} ou {1}"}},
+		currencies:     map[string]string{"USD": "dollar des États-Unis", "EUR": "euro", "GBP": "livre sterling", "JPY": "yen japonais", "CNY": "yuan renminbi chinois"},
+		eras:           map[Calendar][]string{CalendarGregorian: {"av. J.-C.", "ap. J.-C."}},
+	},
+	"it": {
+		months:          [12]string{"gennaio", "febbraio", "marzo", "aprile", "maggio", "giugno", "luglio", "agosto", "settembre", "ottobre", "novembre", "dicembre"},
+		abbrMonths:      [12]string{"gen", "feb", "mar", "apr", "mag", "giu", "lug", "ago", "set", "ott", "nov", "dic"},
+		dateOrder:       "dmy",
+		narrowMonths:    [12]string{"G", "F", "M", "A", "M", "G", "L", "A", "S", "O", "N", "D"},
+		weekdays:        [7]string{"domenica", "lunedì", "martedì", "mercoledì", "giovedì", "venerdì", "sabato"},
+		abbrWeekdays:    [7]string{"dom", "lun", "mar", "mer", "gio", "ven", "sab"},
+		narrowWeekdays:  [7]string{"D", "L", "M", "M", "G", "V", "S"},
+		dateFormats:     [4]string{"EEEE d MMMM y", "d MMMM y", "d MMM y", "dd/MM/yy"},
+		timeFormats:     [4]string{"HH:mm:ss zzzz", "HH:mm:ss z", "HH:mm:ss", "HH:mm"},
+		dateRanges:      [4]string{"d MMM y", "d MMM y – d MMM y", "d MMM – d MMM y", "d–d MMM y"},
+		compactDecimals: [12]string{"0", "0", "0", "0\u00a0Mln", "00\u00a0Mln", "000\u00a0Mln", "0\u00a0Mrd", "00\u00a0Mrd", "000\u00a0Mrd", "0\u00a0Bln", "00\u00a0Bln", "000\u00a0Bln"},
+		units: map[string][3]map[string]string{
+			"day":         {{"one": "{0} giorno", "other": "{0} giorni"}, {"other": "{0} g"}, {"other": "{0}g"}},
+			"hour":        {{"one": "{0} ora", "other": "{0} ore"}, {"other": "{0} h"}, {"other": "{0}h"}},
+			"minute":      {{"one": "{0} minuto", "other": "{0} minuti"}, {"other": "{0} min"}, {"other": "{0}min"}},
+			"second":      {{"one": "{0} secondo", "other": "{0} secondi"}, {"other": "{0} s"}, {"other": "{0}s"}},
+			"millisecond": {{"one": "{0} millisecondo", "other": "{0} millisecondi"}, {"other": "{0} ms"}, {"other": "{0}ms"}},
+			"kilometer":   {{"one": "{0} chilometro", "other": "{0} chilometri"}, {"other": "{0} km"}, {"other": "{0}km"}},
+			"meter":       {{"one": "{0} metro", "other": "{0} metri"}, {"other": "{0} m"}, {"other": "{0}m"}},
+			"mile":        {{"one": "{0} miglio", "other": "{0} miglia"}, {"other": "{0} mi"}, {"other": "{0}mi"}},
+			"foot":        {{"one": "{0} piede", "other": "{0} piedi"}, {"other": "{0} ft"}, {"other": "{0}ft"}},
+			"kilogram":    {{"one": "{0} chilogrammo", "other": "{0} chilogrammi"}, {"other": "{0} kg"}, {"other": "{0}kg"}},
+			"pound":       {{"one": "{0} libbra", "other": "{0} libbre"}, {"other": "{0} lb"}, {"other": "{0}lb"}},
+			"celsius":     {{"one": "{0} grado Celsius", "other": "{0} gradi Celsius"}, {"other": "{0} °C"}, {"other": "{0}°C"}},
+			"fahrenheit":  {{"one": "{0} grado Fahrenheit", "other": "{0} gradi Fahrenheit"}, {"other": "{0} °F"}, {"other": "{0}°F"}},
+		},
+		unitSeparators: [3]string{", ", ", ", " "},
+		lists:          [2][4]string{{"{0} e {1}", "{0}, {1}", "{0}, {1}", "{0} e {1}"}, {"{0} o {1}", "{0}, {1}", "{0}, {1}", "{0} o {1}"}},
+		currencies:     map[string]string{"USD": "dollaro statunitense", "EUR": "euro", "GBP": "sterlina britannica", "JPY": "yen giapponese", "CNY": "renminbi cinese"},
+		eras:           map[Calendar][]string{CalendarGregorian: {"a.C.", "d.C."}},
+	},
+	"ja": {
+		months:          [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+		abbrMonths:      [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+		dateOrder:       "ymd",
+		narrowMonths:    [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+		weekdays:        [7]string{"日曜日", "月曜日", "火曜日", "水曜日", "木曜日", "金曜日", "土曜日"},
+		abbrWeekdays:    [7]string{"日", "月", "火", "水", "木", "金", "土"},
+		narrowWeekdays:  [7]string{"日", "月", "火", "水", "木", "金", "土"},
+		dayPeriods:      [2]string{"午前", "午後"},
+		dateFormats:     [4]string{"y年M月d日EEEE", "y年M月d日", "y/MM/dd", "y/MM/dd"},
+		timeFormats:     [4]string{"H時mm分ss秒 zzzz", "H:mm:ss z", "H:mm:ss", "H:mm"},
+		dateRanges:      [4]string{"y年M月d日", "y年M月d日～y年M月d日", "y年M月d日～M月d日", "y年M月d日～d日"},
+		compactDecimals: [12]string{"0", "0万", "00万", "000万", "0000万", "0億", "00億", "000億", "0000億", "0兆", "00兆", "000兆"},
+		units: map[string][3]map[string]string{
+			"day":         {{"other": "{0} 日"}, {"other": "{0} 日"}, {"other": "{0}日"}},
+			"hour":        {{"other": "{0} 時間"}, {"other": "{0} 時間"}, {"other": "{0}時間"}},
+			"minute":      {{"other": "{0} 分"}, {"other": "{0} 分"}, {"other": "{0}分"}},
+			"second":      {{"other": "{0} 秒"}, {"other": "{0} 秒"}, {"other": "{0}秒"}},
+			"millisecond": {{"other": "{0} ミリ秒"}, {"other": "{0} ms"}, {"other": "{0}ms"}},
+			"kilometer":   {{"other": "{0} キロメートル"}, {"other": "{0} km"}, {"other": "{0}km"}},
+			"meter":       {{"other": "{0} メートル"}, {"other": "{0} m"}, {"other": "{0}m"}},
+			"mile":        {{"other": "{0} マイル"}, {"other": "{0} mi"}, {"other": "{0}mi"}},
+			"foot":        {{"other": "{0} フィート"}, {"other": "{0} ft"}, {"other": "{0}ft"}},
+			"kilogram":    {{"other": "{0} キログラム"}, {"other": "{0} kg"}, {"other": "{0}kg"}},
+			"pound":       {{"other": "{0} ポンド"}, {"other": "{0} lb"}, {"other": "{0}lb"}},
+			"celsius":     {{"other": "摂氏 {0} 度"}, {"other": "{0}°C"}, {"other": "{0}°C"}},
+			"fahrenheit":  {{"other": "華氏 {0} 度"}, {"other": "{0}°F"}, {"other": "{0}°F"}},
+		},
+		unitSeparators: [3]string{" ", " ", " "},
+		lists:          [2][4]string{{"{0}、{1}", "{0}、{1}", "{0}、{1}", "{0}、{1}"}, {"{0}または{1}", "{0}、{1}", "{0}、{1}", "{0}、または{1}"}},
+		currencies:     map[string]string{"USD": "米ドル", "EUR": "ユーロ", "GBP": "英国ポンド", "JPY": "日本円", "CNY": "中国人民元"},
+		eras: map[Calendar][]string{
+			CalendarGregorian: {"紀元前", "西暦"},
+			CalendarJapanese:  {"明治", "大正", "昭和", "平成", "令和"},
+			CalendarBuddhist:  {"仏暦"},
+		},
+	},
+	"ko": {
+		months:          [12]string{"1월", "2월", "3월", "4월", "5월", "6월", "7월", "8월", "9월", "10월", "11월", "12월"},
+		abbrMonths:      [12]string{"1월", "2월", "3월", "4월", "5월", "6월", "7월", "8월", "9월", "10월", "11월", "12월"},
+		dateOrder:       "ymd",
+		narrowMonths:    [12]string{"1월", "2월", "3월", "4월", "5월", "6월", "7월", "8월", "9월", "10월", "11월", "12월"},
+		weekdays:        [7]string{"일요일", "월요일", "화요일", "수요일", "목요일", "금요일", "토요일"},
+		abbrWeekdays:    [7]string{"일", "월", "화", "수", "목", "금", "토"},
+		narrowWeekdays:  [7]string{"일", "월", "화", "수", "목", "금", "토"},
+		dayPeriods:      [2]string{"오전", "오후"},
+		dateFormats:     [4]string{"y년 MMMM d일 EEEE", "y년 MMMM d일", "y. M. d.", "yy. M. d."},
+		timeFormats:     [4]string{"a h시 m분 s초 zzzz", "a h시 m분 s초 z", "a h:mm:ss", "a h:mm"},
+		dateRanges:      [4]string{"y년 M월 d일", "y년 M월 d일 ~ y년 M월 d일", "y년 M월 d일 ~ M월 d일", "y년 M월 d일~d일"},
+		compactDecimals: [12]string{"0천", "0만", "00만", "000만", "0000만", "0억", "00억", "000억", "0000억", "0조", "00조", "000조"},
+		units: map[string][3]map[string]string{
+			"day":         {{"other": "{0}일"}, {"other": "{0}일"}, {"other": "{0}일"}},
+			"hour":        {{"other": "{0}시간"}, {"other": "{0}시간"}, {"other": "{0}시간"}},
+			"minute":      {{"other": "{0}분"}, {"other": "{0}분"}, {"other": "{0}분"}},
+			"second":      {{"other": "{0}초"}, {"other": "{0}초"}, {"other": "{0}초"}},
+			"millisecond": {{"other": "{0}밀리초"}, {"other": "{0}ms"}, {"other": "{0}ms"}},
+			"kilometer":   {{"other": "{0}킬로미터"}, {"other": "{0}km"}, {"other": "{0}km"}},
+			"meter":       {{"other": "{0}미터"}, {"other": "{0}m"}, {"other": "{0}m"}},
+			"mile":        {{"other": "{0}마일"}, {"other": "{0}mi"}, {"other": "{0}mi"}},
+			"foot":        {{"other": "{0}피트"}, {"other": "{0}ft"}, {"other": "{0}ft"}},
+			"kilogram":    {{"other": "{0}킬로그램"}, {"other": "{0}kg"}, {"other": "{0}kg"}},
+			"pound":       {{"other": "{0}파운드"}, {"other": "{0}lb"}, {"other": "{0}lb"}},
+			"celsius":     {{"other": "섭씨 {0}도"}, {"other": "{0}°C"}, {"other": "{0}°C"}},
+			"fahrenheit":  {{"other": "화씨 {0}도"}, {"other": "{0}°F"}, {"other": "{0}°F"}},
+		},
+		unitSeparators: [3]string{" ", " ", " "},
+		lists:          [2][4]string{{"{0} 및 {1}", "{0}, {1}", "{0}, {1}", "{0} 및 {1}"}, {"{0} 또는 {1}", "{0}, {1}", "{0}, {1}", "{0} 또는 {1}"}},
+		currencies:     map[string]string{"USD": "미국 달러", "EUR": "유로", "GBP": "영국 파운드", "JPY": "일본 엔화", "CNY": "중국 위안화"},
+		eras: map[Calendar][]string{
+			CalendarGregorian: {"BC", "AD"},
+			CalendarBuddhist:  {"불기"},
+		},
+	},
+	"nl": {
+		months:          [12]string{"januari", "februari", "maart", "april", "mei", "juni", "juli", "augustus", "september", "oktober", "november", "december"},
+		abbrMonths:      [12]string{"jan", "feb", "mrt", "apr", "mei", "jun", "jul", "aug", "sep", "okt", "nov", "dec"},
+		dateOrder:       "dmy",
+		narrowMonths:    [12]string{"J", "F", "M", "A", "M", "J", "J", "A", "S", "O", "N", "D"},
+		weekdays:        [7]string{"zondag", "maandag", "dinsdag", "woensdag", "donderdag", "vrijdag", "zaterdag"},
+		abbrWeekdays:    [7]string{"zo", "ma", "di", "wo", "do", "vr", "za"},
+		narrowWeekdays:  [7]string{"Z", "M", "D", "W", "D", "V", "Z"},
+		dayPeriods:      [2]string{"a.m.", "p.m."},
+		dateFormats:     [4]string{"EEEE d MMMM y", "d MMMM y", "d MMM y", "dd-MM-y"},
+		timeFormats:     [4]string{"HH:mm:ss zzzz", "HH:mm:ss z", "HH:mm:ss", "HH:mm"},
+		dateRanges:      [4]string{"d MMM y", "d MMM y – d MMM y", "d MMM – d MMM y", "d–d MMM y"},
+		compactDecimals: [12]string{"0K", "00K", "000K", "0\u00a0mln.", "00\u00a0mln.", "000\u00a0mln.", "0\u00a0mld.", "00\u00a0mld.", "000\u00a0mld.", "0\u00a0bln.", "00\u00a0bln.", "000\u00a0bln."},
+		units: map[string][3]map[string]string{
+			"day":         {{"one": "{0} dag", "other": "{0} dagen"}, {"one": "{0} dag", "other": "{0} dagen"}, {"other": "{0} d"}},
+			"hour":        {{"other": "{0} uur"}, {"other": "{0} uur"}, {"other": "{0} u"}},
+			"minute":      {{"one": "{0} minuut", "other": "{0} minuten"}, {"other": "{0} min"}, {"other": "{0} m"}},
+			"second":      {{"one": "{0} seconde", "other": "{0} seconden"}, {"other": "{0} sec"}, {"other": "{0} s"}},
+			"millisecond": {{"one": "{0} milliseconde", "other": "{0} milliseconden"}, {"other": "{0} ms"}, {"other": "{0} ms"}},
+			"kilometer":   {{"other": "{0} kilometer"}, {"other": "{0} km"}, {"other": "{0} km"}},
+			"meter":       {{"other": "{0} meter"}, {"other": "{0} m"}, {"other": "{0} m"}},
+			"mile":        {{"other": "{0} mijl"}, {"other": "{0} mi"}, {"other": "{0} mi"}},
+			"foot":        {{"other": "{0} voet"}, {"other": "{0} ft"}, {"other": "{0} ft"}},
+			"kilogram":    {{"other": "{0} kilogram"}, {"other": "{0} kg"}, {"other": "{0} kg"}},
+			"pound":       {{"other": "{0} pond"}, {"other": "{0} lb"}, {"other": "{0} lb"}},
+			"celsius":     {{"one": "{0} graad Celsius", "other": "{0} graden Celsius"}, {"other": "{0} °C"}, {"other": "{0}°C"}},
+			"fahrenheit":  {{"one": "{0} graad Fahrenheit", "other": "{0} graden Fahrenheit"}, {"other": "{0} °F"}, {"other": "{0}°F"}},
+		},
+		unitSeparators: [3]string{", ", ", ", " "},
+		lists:          [2][4]string{{"{0} en {1}", "{0}, {1}", "{0}, {1}", "{0} en {1}"}, {"{0} of {1}", "{0}, {1}", "{0}, {1}", "{0} of {1}"}},
+		currencies:     map[string]string{"USD": "Amerikaanse dollar", "EUR": "Euro", "GBP": "Brits pond", "JPY": "Japanse yen", "CNY": "Chinese yuan"},
+		eras:           map[Calendar][]string{CalendarGregorian: {"v.Chr.", "n.Chr."}},
+	},
+	"pt": {
+		months:          [12]string{"janeiro", "fevereiro", "março", "abril", "maio", "junho", "julho", "agosto", "setembro", "outubro", "novembro", "dezembro"},
+		abbrMonths:      [12]string{"jan.", "fev.", "mar.", "abr.", "mai.", "jun.", "jul.", "ago.", "set.", "out.", "nov.", "dez."},
+		dateOrder:       "dmy",
+		narrowMonths:    [12]string{"J", "F", "M", "A", "M", "J", "J", "A", "S", "O", "N", "D"},
+		weekdays:        [7]string{"domingo", "segunda-feira", "terça-feira", "quarta-feira", "quinta-feira", "sexta-feira", "sábado"},
+		abbrWeekdays:    [7]string{"dom.", "seg.", "ter.", "qua.", "qui.", "sex.", "sáb."},
+		narrowWeekdays:  [7]string{"D", "S", "T", "Q", "Q", "S", "S"},
+		dateFormats:     [4]string{"EEEE, d 'de' MMMM 'de' y", "d 'de' MMMM 'de' y", "d 'de' MMM 'de' y", "dd/MM/y"},
+		timeFormats:     [4]string{"HH:mm:ss zzzz", "HH:mm:ss z", "HH:mm:ss", "HH:mm"},
+		dateRanges:      [4]string{"d 'de' MMM 'de' y", "d 'de' MMM 'de' y – d 'de' MMM 'de' y", "d 'de' MMM – d 'de' MMM 'de' y", "d – d 'de' MMM 'de' y"},
+		compactDecimals: [12]string{"0\u00a0mil", "00\u00a0mil", "000\u00a0mil", "0\u00a0mi", "00\u00a0mi", "000\u00a0mi", "0\u00a0bi", "00\u00a0bi", "000\u00a0bi", "0\u00a0tri", "00\u00a0tri", "000\u00a0tri"},
+		units: map[string][3]map[string]string{
+			"day":         {{"one": "{0} dia", "other": "{0} dias"}, {"one": "{0} dia", "other": "{0} dias"}, {"one": "{0} dia", "other": "{0} dias"}},
+			"hour":        {{"one": "{0} hora", "other": "{0} horas"}, {"other": "{0} h"}, {"other": "{0}h"}},
+			"minute":      {{"one": "{0} minuto", "other": "{0} minutos"}, {"other": "{0} min"}, {"other": "{0}min"}},
+			"second":      {{"one": "{0} segundo", "other": "{0} segundos"}, {"other": "{0} s"}, {"other": "{0}s"}},
+			"millisecond": {{"one": "{0} milissegundo", "other": "{0} milissegundos"}, {"other": "{0} ms"}, {"other": "{0}ms"}},
+			"kilometer":   {{"one": "{0} quilômetro", "other": "{0} quilômetros"}, {"other": "{0} km"}, {"other": "{0} km"}},
+			"meter":       {{"one": "{0} metro", "other": "{0} metros"}, {"other": "{0} m"}, {"other": "{0} m"}},
+			"mile":        {{"one": "{0} milha", "other": "{0} milhas"}, {"other": "{0} mi"}, {"other": "{0} mi"}},
+			"foot":        {{"one": "{0} pé", "other": "{0} pés"}, {"other": "{0} ft"}, {"other": "{0} ft"}},
+			"kilogram":    {{"one": "{0} quilograma", "other": "{0} quilogramas"}, {"other": "{0} kg"}, {"other": "{0} kg"}},
+			"pound":       {{"one": "{0} libra", "other": "{0} libras"}, {"other": "{0} lb"}, {"other": "{0} lb"}},
+			"celsius":     {{"one": "{0} grau Celsius", "other": "{0} graus Celsius"}, {"other": "{0} °C"}, {"other": "{0}°C"}},
+			"fahrenheit":  {{"one": "{0} grau Fahrenheit", "other": "{0} graus Fahrenheit"}, {"other": "{0} °F"}, {"other": "{0}°F"}},
+		},
+		unitSeparators: [3]string{", ", ", ", " "},
+		lists:          [2][4]string{{"{0} e {1}", "{0}, {1}", "{0}, {1}", "{0} e {1}"}, {"{0} ou {1}", "{0}, {1}", "{0}, {1}", "{0} ou {1}"}},
+		currencies:     map[string]string{"USD": "Dólar americano", "EUR": "Euro", "GBP": "Libra esterlina", "JPY": "Iene japonês", "CNY": "Yuan chinês"},
+		eras:           map[Calendar][]string{CalendarGregorian: {"a.C.", "d.C."}},
+	},
+	"ru": {
+		months:       [12]string{"января", "февраля", "марта", "апреля", "мая", "июня", "июля", "августа", "сентября", "октября", "ноября", "декабря"},
+		abbrMonths:   [12]string{"янв.", "февр.", "мар.", "апр.", "мая", "июн.", "июл.", "авг.", "сент.", "окт.", "нояб.", "дек."},
+		dateOrder:    "dmy",
+		dateLiterals: []string{"г."},
+		standaloneMonths: [2][12]string{
+			{"январь", "февраль", "март", "апрель", "май", "июнь", "июль", "август", "сентябрь", "октябрь", "ноябрь", "декабрь"},
+			{"янв.", "февр.", "март", "апр.", "май", "июнь", "июль", "авг.", "сент.", "окт.", "нояб.", "дек."},
+		},
+		narrowMonths:    [12]string{"Я", "Ф", "М", "А", "М", "И", "И", "А", "С", "О", "Н", "Д"},
+		weekdays:        [7]string{"воскресенье", "понедельник", "вторник", "среда", "четверг", "пятница", "суббота"},
+		abbrWeekdays:    [7]string{"вс", "пн", "вт", "ср", "чт", "пт", "сб"},
+		narrowWeekdays:  [7]string{"В", "П", "В", "С", "Ч", "П", "С"},
+		dateFormats:     [4]string{"EEEE, d MMMM y 'г'.", "d MMMM y 'г'.", "d MMM y 'г'.", "dd.MM.y"},
+		timeFormats:     [4]string{"HH:mm:ss zzzz", "HH:mm:ss z", "HH:mm:ss", "HH:mm"},
+		dateRanges:      [4]string{"d MMM y 'г'.", "d MMM y 'г'. – d MMM y 'г'.", "d MMM – d MMM y 'г'.", "d–d MMM y 'г'."},
+		compactDecimals: [12]string{"0\u00a0тыс.", "00\u00a0тыс.", "000\u00a0тыс.", "0\u00a0млн", "00\u00a0млн", "000\u00a0млн", "0\u00a0млрд", "00\u00a0млрд", "000\u00a0млрд", "0\u00a0трлн", "00\u00a0трлн", "000\u00a0трлн"},
+		units: map[string][3]map[string]string{
+			"day":         {{"one": "{0} день", "few": "{0} дня", "many": "{0} дней", "other": "{0} дня"}, {"other": "{0} дн."}, {"other": "{0} д"}},
+			"hour":        {{"one": "{0} час", "few": "{0} часа", "many": "{0} часов", "other": "{0} часа"}, {"other": "{0} ч"}, {"other": "{0} ч"}},
+			"minute":      {{"one": "{0} минута", "few": "{0} минуты", "many": "{0} минут", "other": "{0} минуты"}, {"other": "{0} мин"}, {"other": "{0} мин"}},
+			"second":      {{"one": "{0} секунда", "few": "{0} секунды", "many": "{0} секунд", "other": "{0} секунды"}, {"other": "{0} с"}, {"other": "{0} с"}},
+			"millisecond": {{"one": "{0} миллисекунда", "few": "{0} миллисекунды", "many": "{0} миллисекунд", "other": "{0} миллисекунды"}, {"other": "{0} мс"}, {"other": "{0} мс"}},
+			"kilometer":   {{"one": "{0} километр", "few": "{0} километра", "many": "{0} километров", "other": "{0} километра"}, {"other": "{0} км"}, {"other": "{0} км"}},
+			"meter":       {{"one": "{0} метр", "few": "{0} метра", "many": "{0} метров", "other": "{0} метра"}, {"other": "{0} м"}, {"other": "{0} м"}},
+			"mile":        {{"one": "{0} миля", "few": "{0} мили", "many": "{0} миль", "other": "{0} мили"}, {"other": "{0} ми"}, {"other": "{0} ми"}},
+			"foot":        {{"one": "{0} фут", "few": "{0} фута", "many": "{0} футов", "other": "{0} фута"}, {"other": "{0} фт"}, {"other": "{0} фт"}},
+			"kilogram":    {{"one": "{0} килограмм", "few": "{0} килограмма", "many": "{0} килограммов", "other": "{0} килограмма"}, {"other": "{0} кг"}, {"other": "{0} кг"}},
+			"pound":       {{"one": "{0} фунт", "few": "{0} фунта", "many": "{0} фунтов", "other": "{0} фунта"}, {"other": "{0} фнт"}, {"other": "{0} фнт"}},
+			"celsius":     {{"one": "{0} градус Цельсия", "few": "{0} градуса Цельсия", "many": "{0} градусов Цельсия", "other": "{0} градуса Цельсия"}, {"other": "{0} °C"}, {"other": "{0}°C"}},
+			"fahrenheit":  {{"one": "{0} градус Фаренгейта", "few": "{0} градуса Фаренгейта", "many": "{0} градусов Фаренгейта", "other": "{0} градуса Фаренгейта"}, {"other": "{0} °F"}, {"other": "{0}°F"}},
+		},
+		unitSeparators: [3]string{" ", " ", " "},
+		lists:          [2][4]string{{"{0} и {1}", "{0}, {1}", "{0}, {1}", "{0} и {1}"}, {"{0} или {1}", "{0}, {1}", "{0}, {1}", "{0} или {1}"}},
+		currencies:     map[string]string{"USD": "доллар США", "EUR": "евро", "GBP": "британский фунт стерлингов", "JPY": "японская иена", "CNY": "китайский юань"},
+		eras:           map[Calendar][]string{CalendarGregorian: {"до н. э.", "н. э."}},
+	},
+	"zh": {
+		months:          [12]string{"一月", "二月", "三月", "四月", "五月", "六月", "七月", "八月", "九月", "十月", "十一月", "十二月"},
+		abbrMonths:      [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+		dateOrder:       "ymd",
+		narrowMonths:    [12]string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12"},
+		weekdays:        [7]string{"星期日", "星期一", "星期二", "星期三", "星期四", "星期五", "星期六"},
+		abbrWeekdays:    [7]string{"周日", "周一", "周二", "周三", "周四", "周五", "周六"},
+		narrowWeekdays:  [7]string{"日", "一", "二", "三", "四", "五", "六"},
+		dayPeriods:      [2]string{"上午", "下午"},
+		dateFormats:     [4]string{"y年M月d日EEEE", "y年M月d日", "y年M月d日", "y/M/d"},
+		timeFormats:     [4]string{"zzzz HH:mm:ss", "z HH:mm:ss", "HH:mm:ss", "HH:mm"},
+		dateRanges:      [4]string{"y年M月d日", "y年M月d日至y年M月d日", "y年M月d日至M月d日", "y年M月d日至d日"},
+		compactDecimals: [12]string{"0", "0万", "00万", "000万", "0000万", "0亿", "00亿", "000亿", "0000亿", "0万亿", "00万亿", "000万亿"},
+		units: map[string][3]map[string]string{
+			"day":         {{"other": "{0}天"}, {"other": "{0}天"}, {"other": "{0}天"}},
+			"hour":        {{"other": "{0}小时"}, {"other": "{0}小时"}, {"other": "{0}小时"}},
+			"minute":      {{"other": "{0}分钟"}, {"other": "{0}分钟"}, {"other": "{0}分钟"}},
+			"second":      {{"other": "{0}秒钟"}, {"other": "{0}秒"}, {"other": "{0}秒"}},
+			"millisecond": {{"other": "{0}毫秒"}, {"other": "{0}毫秒"}, {"other": "{0}毫秒"}},
+			"kilometer":   {{"other": "{0}公里"}, {"other": "{0}公里"}, {"other": "{0}公里"}},
+			"meter":       {{"other": "{0}米"}, {"other": "{0}米"}, {"other": "{0}米"}},
+			"mile":        {{"other": "{0}英里"}, {"other": "{0}英里"}, {"other": "{0}英里"}},
+			"foot":        {{"other": "{0}英尺"}, {"other": "{0}英尺"}, {"other": "{0}英尺"}},
+			"kilogram":    {{"other": "{0}公斤"}, {"other": "{0}公斤"}, {"other": "{0}公斤"}},
+			"pound":       {{"other": "{0}磅"}, {"other": "{0}磅"}, {"other": "{0}磅"}},
+			"celsius":     {{"other": "{0}摄氏度"}, {"other": "{0}°C"}, {"other": "{0}°C"}},
+			"fahrenheit":  {{"other": "{0}华氏度"}, {"other": "{0}°F"}, {"other": "{0}°F"}},
+		},
+		unitSeparators: [3]string{"", "", ""},
+		lists:          [2][4]string{{"{0}和{1}", "{0}、{1}", "{0}、{1}", "{0}和{1}"}, {"{0}或{1}", "{0}、{1}", "{0}、{1}", "{0}或{1}"}},
+		currencies:     map[string]string{"USD": "美元", "EUR": "欧元", "GBP": "英镑", "JPY": "日元", "CNY": "人民币"},
+		eras: map[Calendar][]string{
+			CalendarGregorian: {"公元前", "公元"},
+			CalendarJapanese:  {"明治", "大正", "昭和", "平成", "令和"},
+			CalendarBuddhist:  {"佛历"},
+		},
+	},
+}
+
+func init() {
+	// en-001 is the parent of the English variants outside the US (en-GB, en-AU, en-IN...).
+	en001 := *localeDataTable["en"]
+	en001.dateOrder = "dmy"
+	en001.dateFormats = [4]string{"EEEE, d MMMM y", "d MMMM y", "d MMM y", "dd/MM/y"}
+	en001.dateRanges = [4]string{"d MMM y", "d MMM y – d MMM y", "d MMM – d MMM y", "d–d MMM y"}
+	// The English variants outside the US have no serial comma, like `a, b and c`.
+	en001.lists[0][3], en001.lists[1][3] = "{0} and {1}", "{0} or {1}"
+	localeDataTable["en-001"] = &en001
+	// en-GB uses the 24-hour clock.
+	enGB := en001
+	enGB.timeFormats = [4]string{"HH:mm:ss zzzz", "HH:mm:ss z", "HH:mm:ss", "HH:mm"}
+	localeDataTable["en-GB"] = &enGB
+}
+
+// HasLocaleData indicates whether the CLDR data of the dates, the lists, the units and the compact numbers of a locale
+// are built in, for the locale itself or a CLDR parent like `fr` for `fr-CA`. The built-in languages are `en`, `de`,
+// `es`, `fr`, `it`, `ja`, `ko`, `nl`, `pt`, `ru` and `zh`; the other locales, like `ar`, `he` and `th`, are formatted
+// with the names and the patterns of `en`, and only their digits and separators are their own.
+func HasLocaleData(locale string) bool {
+	tag, err := language.Parse(locale)
+	if err != nil {
+		return false
+	}
+	_, ok := findLocaleData(tag)
+	return ok
+}
+
+// lookupLocaleData returns the data of the nearest locale in the CLDR parent chain of the tag,
+// the data of `en` if none.
+func lookupLocaleData(tag language.Tag) *localeData {
+	if data, ok := findLocaleData(tag); ok {
+		return data
+	}
+	return localeDataTable["en"]
+}
+
+// findLocaleData returns the data of the nearest locale in the CLDR parent chain of the tag, false if none.
+func findLocaleData(tag language.Tag) (*localeData, bool) {
+	for t := tag; !t.IsRoot(); t = t.Parent() {
+		if data, ok := localeDataTable[t.String()]; ok {
+			return data, true
+		}
+	}
+	if base, _ := tag.Base(); base.String() != "und" {
+		if data, ok := localeDataTable[base.String()]; ok {
+			return data, true
+		}
+	}
+	return nil, false
+}
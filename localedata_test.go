@@ -0,0 +1,29 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+)
+
+func TestLookupLocaleData(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("mdy", lookupLocaleData(language.Make("en-US")).dateOrder)
+	assert.Equal("dmy", lookupLocaleData(language.Make("en-AU")).dateOrder)
+	assert.Equal("janvier", lookupLocaleData(language.Make("fr-CA")).months[0])
+	assert.Equal("ymd", lookupLocaleData(language.Make("zh-TW")).dateOrder)
+	assert.Equal(localeDataTable["en"], lookupLocaleData(language.Make("sw")))
+}
+
+func TestHasLocaleData(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.True(HasLocaleData("en"))
+	assert.True(HasLocaleData("fr-CA"))
+	assert.True(HasLocaleData("zh-Hant-TW"))
+	assert.False(HasLocaleData("ar"))
+	assert.False(HasLocaleData("th-TH"))
+	assert.False(HasLocaleData("not a locale!"))
+}
@@ -0,0 +1,91 @@
+package i18n
+
+import "time"
+
+// Localizable is implemented by the domain types (enums, statuses, error codes...) that carry
+// their own localization logic. The `Vars` values and the `Getf` arguments that implement it
+// are rendered with the localizer of the message.
+//
+//	func (s Status) Localize(l *i18n.Localizer) string {
+//		return l.GetX(string(s), "status")
+//	}
+type Localizable interface {
+	Localize(l *Localizer) string
+}
+
+// Message is a message to translate later, created by the domain code that doesn't know the locale and
+// resolved at the presentation edge with `Localizer.Resolve`. It's `Localizable`, so it can be nested in `Vars`.
+type Message struct {
+	// Name is the name of the message, use `NX` to add a context.
+	Name string
+	// Vars is the data of the message.
+	Vars Vars
+}
+
+// NewMessage returns a message to translate later, see `Message`.
+func NewMessage(name string, vars ...Vars) Message {
+	m := Message{Name: name}
+	if len(vars) > 0 {
+		m.Vars = vars[0]
+	}
+	return m
+}
+
+// Localize translates the message with the localizer.
+func (m Message) Localize(l *Localizer) string {
+	return l.Resolve(m)
+}
+
+// Resolve translates a message created without a locale, see `Message`.
+func (localizer *Localizer) Resolve(m Message) string {
+	if m.Vars == nil {
+		return localizer.Get(m.Name)
+	}
+	return localizer.Get(m.Name, m.Vars)
+}
+
+// localizeVars returns the data with the `Localizable` values rendered, the numbers converted
+// by `pluralOperand` and the times tagged with the calendar of the localizer, the data is copied only if needed.
+func (localizer *Localizer) localizeVars(data Vars) Vars {
+	var localized Vars
+	for k, v := range data {
+		var converted any
+		if l, ok := v.(Localizable); ok {
+			converted = l.Localize(localizer)
+		} else if n, ok := pluralOperand(v); ok && n != v {
+			converted = n
+		} else if t, ok := v.(time.Time); ok && localizer.calendar != "" {
+			converted = calendarTime{Time: t, calendar: localizer.calendar}
+		} else {
+			continue
+		}
+		if localized == nil {
+			localized = make(Vars, len(data))
+			for k, v := range data {
+				localized[k] = v
+			}
+		}
+		localized[k] = converted
+	}
+	if localized == nil {
+		return data
+	}
+	return localized
+}
+
+// localizeArgs renders the `Localizable` arguments of `Getf`, the arguments are copied only if needed.
+func (localizer *Localizer) localizeArgs(args []interface{}) []interface{} {
+	var localized []interface{}
+	for i, v := range args {
+		if v, ok := v.(Localizable); ok {
+			if localized == nil {
+				localized = append([]interface{}(nil), args...)
+			}
+			localized[i] = v.Localize(localizer)
+		}
+	}
+	if localized == nil {
+		return args
+	}
+	return localized
+}
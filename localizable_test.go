@@ -0,0 +1,66 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testStatus string
+
+func (s testStatus) Localize(l *Localizer) string {
+	return l.GetX(string(s), "status")
+}
+
+func TestLocalizable(t *testing.T) {
+	assert := assert.New(t)
+
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "zh-Hans"),
+	)
+	bundle.LoadMessages(map[string]map[string]string{
+		"en": {
+			"order_status":      "Order is {status}",
+			"shipped <status>":  "shipped",
+			"order_status_text": "Order is %s",
+		},
+		"zh-Hans": {
+			"order_status":      "订单{status}",
+			"shipped <status>":  "已发货",
+			"order_status_text": "订单%s",
+		},
+	})
+
+	vars := Vars{"status": testStatus("shipped")}
+	localizer := bundle.NewLocalizer("zh-Hans")
+	assert.Equal("订单已发货", localizer.Get("order_status", vars))
+	assert.Equal("订单已发货", localizer.Getf("order_status_text", testStatus("shipped")))
+	assert.Equal(testStatus("shipped"), vars["status"])
+
+	localizer = bundle.NewLocalizer("en")
+	assert.Equal("Order is shipped", localizer.Get("order_status", vars))
+}
+
+func TestMessage(t *testing.T) {
+	assert := assert.New(t)
+
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "zh-Hans"),
+	)
+	bundle.LoadMessages(map[string]map[string]string{
+		"zh-Hans": {
+			"order_shipped":   "订单 {id} 已发货",
+			"notification":    "通知：{message}",
+			"Shipped <email>": "已寄出",
+		},
+	})
+
+	shipped := NewMessage("order_shipped", Vars{"id": 42})
+	localizer := bundle.NewLocalizer("zh-Hans")
+	assert.Equal("订单 42 已发货", localizer.Resolve(shipped))
+	assert.Equal("通知：订单 42 已发货", localizer.Get("notification", Vars{"message": shipped}))
+	assert.Equal("已寄出", localizer.Resolve(NewMessage(NX("Shipped", "email"))))
+	assert.Equal("Order 42 shipped", bundle.NewLocalizer("en").Resolve(Message{Name: "Order {id} shipped", Vars: shipped.Vars}))
+}
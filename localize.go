@@ -0,0 +1,88 @@
+package i18n
+
+// LocalizeParams describes a message for `Localizer.Localize`, the fields are optional except `Key`.
+type LocalizeParams struct {
+	// Key is the name of the message.
+	Key string
+	// Context disambiguates the messages of the same key like `GetX`.
+	Context string
+	// Count is passed as the `count` var, and selects the message of its plural category like `GetPlural`.
+	Count any
+	// Default is the message used if the key is missing, a text-based message of the default locale,
+	// also formatted with `WithRuntimeParsing(false)`.
+	Default string
+	// Vars is the data of the message.
+	Vars Vars
+}
+
+// Localize returns a translated string, combining the context, the plural count and the default message
+// of the params in a single call.
+func (localizer *Localizer) Localize(params *LocalizeParams) string {
+	name := messageName(params.Key, params.Context)
+	vars := params.Vars
+	if params.Count != nil {
+		name = localizer.pluralName(params.Key, params.Context, params.Count)
+		vars = withCount(vars, params.Count)
+	}
+
+	var data []Vars
+	if vars != nil {
+		data = []Vars{vars}
+	}
+	if params.Default == "" || localizer.Has(name) {
+		return localizer.Get(name, data...)
+	}
+
+	// The key is reported as missing, and the default is rendered instead.
+	localizer.observe(name, false, data...)
+	bundle := localizer.bundle
+	if !bundle.frozen {
+		bundle.mu.RLock()
+	}
+	fallback := localizer.runtimeFallback()
+	if !bundle.frozen {
+		bundle.mu.RUnlock()
+	}
+	// The default is a message given by the caller, it's rendered even if the runtime parsing is disabled.
+	trans, err := fallback.parse(params.Default)
+	if err != nil {
+		return params.Default
+	}
+	return localizer.localize(trans, data...)
+}
+
+// pluralName returns the name of the message of the count: the name itself if it's a message, otherwise the name
+// suffixed with the plural category of the count, then with `.other`, see `GetPlural`.
+func (localizer *Localizer) pluralName(name, context string, count any) string {
+	if full := messageName(name, context); localizer.Has(full) {
+		return full
+	}
+	category, err := localizer.bundle.PluralCategory(localizer.locale, count)
+	if err != nil {
+		category = "other"
+	}
+	for _, suffix := range []string{category, "other"} {
+		if suffixed := messageName(name+"."+suffix, context); localizer.Has(suffixed) {
+			return suffixed
+		}
+	}
+	return messageName(name, context)
+}
+
+// messageName names a message with its context like `withContext`, if any.
+func messageName(name, context string) string {
+	if context == "" {
+		return name
+	}
+	return withContext(name, context)
+}
+
+// withCount returns a copy of the data with the count as the `count` var.
+func withCount(data Vars, count any) Vars {
+	vars := make(Vars, len(data)+1)
+	for k, v := range data {
+		vars[k] = v
+	}
+	vars["count"] = count
+	return vars
+}
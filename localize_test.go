@@ -0,0 +1,67 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalize(t *testing.T) {
+	assert := assert.New(t)
+
+	var missing []string
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "zh-Hans"),
+		WithMissingHandler(func(e LookupEvent) {
+			missing = append(missing, e.Name)
+		}),
+	)
+	assert.NoError(bundle.LoadMessages(map[string]map[string]string{
+		"zh-Hans": {
+			"Post <verb>":       "发表",
+			"post.other <noun>": "{count} 篇文章",
+			"hello":             "你好，{name}",
+		},
+	}))
+	localizer := bundle.NewLocalizer("zh-Hans")
+
+	assert.Equal("发表", localizer.Localize(&LocalizeParams{Key: "Post", Context: "verb"}))
+	assert.Equal("3 篇文章", localizer.Localize(&LocalizeParams{Key: "post", Context: "noun", Count: 3}))
+	assert.Equal("你好，Yami", localizer.Localize(&LocalizeParams{
+		Key:     "hello",
+		Default: "Hello, {name}",
+		Vars:    Vars{"name": "Yami"},
+	}))
+	assert.Empty(missing)
+
+	assert.Equal("2 comments by Yami", localizer.Localize(&LocalizeParams{
+		Key:     "comments",
+		Context: "noun",
+		Count:   2,
+		Default: "{count, plural, one {# comment} other {# comments}} by {name}",
+		Vars:    Vars{"name": "Yami"},
+	}))
+	assert.Equal([]string{"comments"}, missing)
+}
+
+func TestLocalizeDefaultWithoutRuntimeParsing(t *testing.T) {
+	assert := assert.New(t)
+
+	bundle := NewBundle(WithDefaultLocale("en"), WithLocales("en"), WithRuntimeParsing(false))
+	localizer := bundle.NewLocalizer("en")
+
+	assert.Equal("3 comments by Yami", localizer.Localize(&LocalizeParams{
+		Key:     "comments",
+		Count:   3,
+		Default: "{count, plural, one {# comment} other {# comments}} by {name}",
+		Vars:    Vars{"name": "Yami"},
+	}))
+	assert.Equal("Hello, Yami", localizer.Localize(&LocalizeParams{
+		Key:     "hello",
+		Default: "Hello, {name}",
+		Vars:    Vars{"name": "Yami"},
+	}))
+	// The names of the missing messages are still not parsed.
+	assert.Equal("comments", localizer.Localize(&LocalizeParams{Key: "comments"}))
+}
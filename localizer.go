@@ -1,12 +1,31 @@
 package i18n
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"maps"
+
+	"golang.org/x/text/language"
+)
+
+// ErrMissingMessage is returned by `GetE` when a message isn't in the catalogs of the locale nor its fallbacks.
+var ErrMissingMessage = errors.New("i18n: missing message")
+
+// ErrFormatMessage is returned by `GetE` when a message cannot be formatted with its data.
+var ErrFormatMessage = errors.New("i18n: cannot format message")
 
 // Localizer represents a translated locale.
 type Localizer struct {
 	bundle *I18n
 
 	locale string
+	tenant string
+	// vars are merged into the data of every message, see `WithVars`.
+	vars Vars
+	// calendar formats the dates if it's not empty, see `WithCalendar`.
+	calendar Calendar
 }
 
 // Localizer returns the current locale name.
@@ -16,58 +35,398 @@ func (localizer *Localizer) Locale() string {
 
 // String returns a translated string.
 func (localizer *Localizer) Get(name string, data ...Vars) string {
-	selectedTrans, err := localizer.lookup(name)
+	selectedTrans, found, err := localizer.lookup(name)
+	localizer.observe(name, found, data...)
+	if err != nil || selectedTrans == nil {
+		return localizer.mark(name, localizer.missing(name))
+	}
+
+	str := localizer.localize(selectedTrans, data...)
+	if !found {
+		return localizer.mark(name, str)
+	}
+	return str
+}
+
+// GetE returns a translated string like `Get`, and an error if the message cannot be translated as is:
+// `ErrMissingMessage`, `ErrInvalidMessage` if it doesn't compile, or `ErrFormatMessage`.
+func (localizer *Localizer) GetE(name string, data ...Vars) (string, error) {
+	selectedTrans, found, err := localizer.lookup(name)
+	localizer.observe(name, found, data...)
+	if err != nil {
+		return localizer.mark(name, localizer.missing(name)), fmt.Errorf("%w: %q: %v", ErrInvalidMessage, name, err)
+	}
+	if selectedTrans == nil {
+		return localizer.mark(name, localizer.missing(name)), fmt.Errorf("%w: %q", ErrMissingMessage, name)
+	}
+
+	str, err := localizer.localizeE(selectedTrans, data...)
+	if !found {
+		str = localizer.mark(name, str)
+		if err == nil {
+			err = fmt.Errorf("%w: %q", ErrMissingMessage, name)
+		}
+	}
+	return str, err
+}
+
+// MustGet returns a translated string, it panics if the message cannot be translated as is, see `GetE`.
+// The panic is replaced by the handler of `WithMustHandler` if set, the string is then returned anyway.
+func (localizer *Localizer) MustGet(name string, data ...Vars) string {
+	str, err := localizer.GetE(name, data...)
 	if err != nil {
-		return name
+		if localizer.bundle.mustHandler == nil {
+			panic(err)
+		}
+		localizer.bundle.mustHandler(err)
+	}
+	return str
+}
+
+// GetBytes returns a translated string as bytes.
+func (localizer *Localizer) GetBytes(name string, data ...Vars) []byte {
+	return localizer.AppendTo(nil, name, data...)
+}
+
+// AppendTo appends a translated string to dst and returns the extended buffer.
+func (localizer *Localizer) AppendTo(dst []byte, name string, data ...Vars) []byte {
+	return append(dst, localizer.Get(name, data...)...)
+}
+
+// WriteTo writes a translated string to w, it returns the number of bytes written.
+func (localizer *Localizer) WriteTo(w io.Writer, name string, data ...Vars) (int, error) {
+	return io.WriteString(w, localizer.Get(name, data...))
+}
+
+// WithVars returns a copy of the localizer that merges the vars into the data of every message, like the name or
+// the timezone of the current user. The data passed to a message overrides them.
+func (localizer *Localizer) WithVars(vars Vars) *Localizer {
+	l := *localizer
+	l.vars = localizer.mergeVars(vars)
+	return &l
+}
+
+// mergeVars returns a copy of the vars of the localizer with the data merged into it.
+func (localizer *Localizer) mergeVars(data ...Vars) Vars {
+	merged := make(Vars, len(localizer.vars))
+	for k, v := range localizer.vars {
+		merged[k] = v
+	}
+	if len(data) > 0 {
+		for k, v := range data[0] {
+			merged[k] = v
+		}
 	}
+	return merged
+}
+
+// Has indicates whether a message is in the catalogs of the localizer, see `I18n.HasTranslation`.
+// The overrides of the tenant count, the missing messages are neither parsed nor reported.
+func (localizer *Localizer) Has(name string) bool {
+	bundle := localizer.bundle
+	if !bundle.frozen {
+		bundle.mu.RLock()
+		defer bundle.mu.RUnlock()
+	}
+	_, found := localizer.lookupCatalog(name)
+	return found
+}
 
-	return localizer.localize(selectedTrans, data...)
+// GetPlural returns a translated string with the count passed as the `count` var. If the name isn't a message itself,
+// the message of the plural category of the count is looked up by its suffix, like `files.one`, then `files.other`.
+func (localizer *Localizer) GetPlural(name string, count any, data ...Vars) string {
+	var vars Vars
+	if len(data) > 0 {
+		vars = data[0]
+	}
+	return localizer.Get(localizer.pluralName(name, "", count), withCount(vars, count))
 }
 
 // GetX returns a translated string with a specified context.
 func (localizer *Localizer) GetX(name, context string, data ...Vars) string {
-	return localizer.Get(fmt.Sprintf("%s <%s>", name, context), data...)
+	// The name is built on the stack to look up a translated message, it's only allocated
+	// if the lookup needs to keep it (missing messages, tenants and hooks).
+	var buf [64]byte
+	key := appendContext(buf[:0], name, context)
+	if selectedTrans := localizer.lookupStatic(key); selectedTrans != nil {
+		return localizer.localize(selectedTrans, data...)
+	}
+	return localizer.Get(string(key), data...)
+}
+
+// withContext names a message with a context like `Post <verb>`.
+func withContext(name, context string) string {
+	return name + " <" + context + ">"
+}
+
+// appendContext appends the name of a message with a context to the buffer, see `withContext`.
+func appendContext(buf []byte, name, context string) []byte {
+	buf = append(buf, name...)
+	buf = append(buf, " <"...)
+	buf = append(buf, context...)
+	return append(buf, '>')
 }
 
 // String returns a translated string with sprintf support.
 func (localizer *Localizer) Getf(name string, data ...interface{}) string {
-	selectedTrans, err := localizer.lookup(name)
-	if err != nil {
-		return name
+	selectedTrans, found, err := localizer.lookup(name)
+	localizer.observe(name, found)
+	if err != nil || selectedTrans == nil {
+		return localizer.mark(name, localizer.missing(name))
 	}
 
-	return fmt.Sprintf(localizer.localize(selectedTrans), data...)
+	str := fmt.Sprintf(localizer.localize(selectedTrans), localizer.localizeArgs(data)...)
+	if !found {
+		return localizer.mark(name, str)
+	}
+	return str
+}
+
+// GetMany returns the translated strings of the names, keyed by the names, see `GetSeq`.
+func (localizer *Localizer) GetMany(names []string, data ...Vars) map[string]string {
+	return maps.Collect(localizer.GetSeq(names, data...))
+}
+
+// GetSeq returns an iterator over the names and their translated strings, the data is passed to every message.
+// The catalogs are read under a single lock for all the names when the iteration starts.
+func (localizer *Localizer) GetSeq(names []string, data ...Vars) iter.Seq2[string, string] {
+	return func(yield func(string, string) bool) {
+		bundle := localizer.bundle
+
+		translations := make([]*parsedTranslation, len(names))
+		found := make([]bool, len(names))
+		if !bundle.frozen {
+			bundle.mu.RLock()
+		}
+		for i, name := range names {
+			translations[i], found[i] = localizer.lookupCatalog(name)
+		}
+		fallback := localizer.runtimeFallback()
+		if !bundle.frozen {
+			bundle.mu.RUnlock()
+		}
+
+		for i, name := range names {
+			selectedTrans, err := translations[i], error(nil)
+			if !found[i] {
+				selectedTrans, err = fallback.lookup(name)
+			}
+			localizer.observe(name, found[i], data...)
+			text := localizer.missing(name)
+			if err == nil && selectedTrans != nil {
+				text = localizer.localize(selectedTrans, data...)
+			}
+			if !found[i] {
+				text = localizer.mark(name, text)
+			}
+			if !yield(name, text) {
+				return
+			}
+		}
+	}
 }
 
-// lookup
-func (localizer *Localizer) lookup(name string) (*parsedTranslation, error) {
-	if selectedTrans, ok := localizer.bundle.parsedTranslations[localizer.locale][name]; ok {
-		return selectedTrans, nil
+// lookup returns the translation of the name, `found` is false if the translation
+// was parsed from the name at runtime, or nil if the runtime parsing is disabled.
+func (localizer *Localizer) lookup(name string) (trans *parsedTranslation, found bool, err error) {
+	bundle := localizer.bundle
+
+	// A frozen bundle never changes, it's read without the lock.
+	if !bundle.frozen {
+		bundle.mu.RLock()
+	}
+	selectedTrans, found := localizer.lookupCatalog(name)
+	fallback := localizer.runtimeFallback()
+	if !bundle.frozen {
+		bundle.mu.RUnlock()
 	}
-	runtimeTrans, ok := localizer.bundle.runtimeParsedTranslations[name]
-	if !ok {
-		var err error
-		runtimeTrans, err = localizer.bundle.parseTranslation(localizer.bundle.defaultLocale, name, trimContext(name))
-		if err != nil {
+
+	if found {
+		return selectedTrans, true, nil
+	}
+	trans, err = fallback.lookup(name)
+	return trans, false, err
+}
+
+// lookupCatalog returns the translation of the name from the catalogs and the overrides of the tenant,
+// the caller must hold the lock.
+func (localizer *Localizer) lookupCatalog(name string) (*parsedTranslation, bool) {
+	selectedTrans, found := localizer.bundle.parsedTranslations[localizer.locale][name]
+	if localizer.tenant != "" {
+		if override := localizer.lookupTenant(name, selectedTrans); override != nil {
+			selectedTrans, found = override, true
+		}
+	}
+	return selectedTrans, found
+}
+
+// runtimeFallback returns the state of the bundle to parse the missing messages at runtime,
+// the caller must hold the lock.
+func (localizer *Localizer) runtimeFallback() runtimeFallback {
+	bundle := localizer.bundle
+	return runtimeFallback{
+		bundle:          bundle,
+		cache:           bundle.runtimeParsedTranslations,
+		defaultLocale:   bundle.defaultLocale,
+		defaultLanguage: bundle.defaultLanguage,
+	}
+}
+
+// runtimeFallback parses the names of the missing messages as the messages of the default locale.
+type runtimeFallback struct {
+	bundle          *I18n
+	cache           *runtimeCache
+	defaultLocale   string
+	defaultLanguage language.Tag
+}
+
+// lookup returns the translation parsed from the name, or nil if the runtime parsing is disabled.
+func (fallback runtimeFallback) lookup(name string) (*parsedTranslation, error) {
+	if fallback.bundle.disableRuntimeParsing {
+		return nil, nil
+	}
+	return fallback.parse(name)
+}
+
+// parse returns the translation parsed from the name even if the runtime parsing is disabled, cached by name.
+func (fallback runtimeFallback) parse(name string) (*parsedTranslation, error) {
+	bundle, cache := fallback.bundle, fallback.cache
+	// The cache of a frozen bundle is never written, it's read without its lock either.
+	get := cache.get
+	if bundle.frozen {
+		get = cache.peek
+	}
+	if runtimeTrans, ok := get(name); ok {
+		return runtimeTrans, nil
+	}
+	runtimeTrans, err := bundle.parseTranslation(fallback.defaultLocale, fallback.defaultLanguage, name, trimContext(name))
+	if err != nil {
+		return nil, err
+	}
+	runtimeTrans.runtime = true
+	if !bundle.lazyCompile {
+		if _, err := runtimeTrans.compile(); err != nil {
+			bundle.reportCompileError(runtimeTrans, err)
 			return nil, err
 		}
 	}
-	localizer.bundle.runtimeParsedTranslations[name] = runtimeTrans
+	if !bundle.frozen {
+		cache.add(name, runtimeTrans)
+	}
 	return runtimeTrans, nil
 }
 
+// lookupStatic returns the translation of the name from the catalogs only, or nil if the lookup
+// has to go through `lookup`: the message is missing, or the lookup is overridden by a tenant or observed by hooks.
+func (localizer *Localizer) lookupStatic(name []byte) *parsedTranslation {
+	bundle := localizer.bundle
+	if localizer.tenant != "" || len(bundle.lookupHooks) != 0 {
+		return nil
+	}
+
+	if !bundle.frozen {
+		bundle.mu.RLock()
+	}
+	selectedTrans := bundle.parsedTranslations[localizer.locale][string(name)]
+	if !bundle.frozen {
+		bundle.mu.RUnlock()
+	}
+	return selectedTrans
+}
+
+// observe reports the lookup to the hooks of the bundle, and the missing messages to the missing handlers.
+func (localizer *Localizer) observe(name string, found bool, data ...Vars) {
+	bundle := localizer.bundle
+	if len(bundle.lookupHooks) == 0 && (found || !bundle.reportsMissing()) {
+		return
+	}
+	event := LookupEvent{
+		Locale:  localizer.locale,
+		Missing: !found,
+	}
+	event.Name, event.Context = splitContext(name)
+	if len(data) > 0 {
+		event.Vars = data[0]
+	}
+	for _, hook := range bundle.lookupHooks {
+		hook(event)
+	}
+	if found || !bundle.reportsMissing() {
+		return
+	}
+	if bundle.missingLimiter != nil {
+		ok, suppressed := bundle.missingLimiter.allow(event)
+		if !ok {
+			return
+		}
+		event.Suppressed = suppressed
+	}
+	// The suggestions scan the catalogs, they're only computed for the reports that are sent.
+	if bundle.debug {
+		event.Suggestions = bundle.suggest(localizer.locale, name)
+	}
+	bundle.reportMissing(event)
+}
+
+// missing returns the output of a message that cannot be translated.
+func (localizer *Localizer) missing(name string) string {
+	name, _ = splitContext(name)
+	return name
+}
+
+// mark returns the output of a missing message: empty if the names aren't returned (see `WithReturnKeyOnMissing`),
+// and marked by the marker of the bundle if any (see `WithMissingMarker`).
+func (localizer *Localizer) mark(name, text string) string {
+	if localizer.bundle.hideMissingKeys {
+		text = ""
+	}
+	marker := localizer.bundle.missingMarker
+	if marker == nil {
+		return text
+	}
+	name, _ = splitContext(name)
+	return marker(name, text)
+}
+
 // localize
 func (localizer *Localizer) localize(tran *parsedTranslation, data ...Vars) string {
-	if len(data) == 0 {
-		return tran.text
-	}
+	str, _ := localizer.localizeE(tran, data...)
+	return str
+}
 
-	if tran.format != nil {
-		str, err := tran.format.FormatMap(data[0])
+// localizeE formats the translation with the data, the text of the translation is returned as is on error.
+func (localizer *Localizer) localizeE(tran *parsedTranslation, data ...Vars) (string, error) {
+	if len(localizer.vars) > 0 && !tran.static {
+		data = []Vars{localizer.mergeVars(data...)}
+	}
+	if len(localizer.bundle.fallbackHooks) > 0 && tran.locale != localizer.locale && !tran.runtime {
+		localizer.reportFallback(tran)
+	}
+	if len(data) == 0 || tran.static {
+		return tran.text, nil
+	}
 
-		if err == nil {
-			return str
+	vars := localizer.localizeVars(data[0])
+	if localizer.bundle.bidiIsolation && isRTL(localizer.tag()) {
+		vars = isolateVars(vars, tran.isolatedArguments())
+	}
+	if tran.fn != nil {
+		w := &MessageWriter{tag: tran.tag, plural: tran.pluralFunc}
+		tran.fn(w, vars)
+		if err := w.Err(); err != nil {
+			return tran.text, fmt.Errorf("%w: %q: %v", ErrFormatMessage, tran.name, err)
 		}
+		return w.String(), nil
+	}
+
+	format, err := tran.compile()
+	if err != nil {
+		localizer.bundle.reportCompileError(tran, err)
+		return tran.text, fmt.Errorf("%w: %q: %v", ErrInvalidMessage, tran.name, err)
+	}
+	str, err := format.FormatMap(vars)
+	if err != nil {
+		return tran.text, fmt.Errorf("%w: %q: %v", ErrFormatMessage, tran.name, err)
 	}
-	return tran.text
+	return str, nil
 }
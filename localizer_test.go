@@ -1,6 +1,8 @@
 package i18n
 
 import (
+	"bytes"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -85,6 +87,130 @@ func TestTokenVars(t *testing.T) {
 	}))
 }
 
+func TestTokenErrors(t *testing.T) {
+	assert := assert.New(t)
+	bundle := NewBundle(WithDefaultLocale("en"), WithLocales("en", "zh-Hans"), WithLazyCompile(true))
+	assert.NoError(bundle.LoadMessages(map[string]map[string]string{
+		"zh-Hans": {
+			"hello":  "你好，{name}",
+			"broken": "{count, plural, one {# 个}",
+		},
+	}))
+	localizer := bundle.NewLocalizer("zh-Hans")
+
+	str, err := localizer.GetE("hello", Vars{"name": "Yami"})
+	assert.NoError(err)
+	assert.Equal("你好，Yami", str)
+
+	str, err = localizer.GetE("Hello")
+	assert.True(errors.Is(err, ErrMissingMessage))
+	assert.Equal("Hello", str)
+
+	str, err = localizer.GetE("broken", Vars{"count": 1})
+	assert.True(errors.Is(err, ErrInvalidMessage))
+	assert.Equal("{count, plural, one {# 个}", str)
+
+	str, err = localizer.GetE("hello", Vars{"name": struct{}{}})
+	assert.True(errors.Is(err, ErrFormatMessage))
+	assert.Equal("你好，{name}", str)
+}
+
+func TestTokenMust(t *testing.T) {
+	assert := assert.New(t)
+	localizer := newTestLocalizer()
+
+	assert.Equal("这是一则测试讯息。", localizer.MustGet("test_message"))
+	assert.PanicsWithError(`i18n: missing message: "not_exists_message"`, func() {
+		localizer.MustGet("not_exists_message")
+	})
+
+	var failures []error
+	bundle := NewBundle(WithDefaultLocale("en"), WithMustHandler(func(err error) {
+		failures = append(failures, err)
+	}))
+	assert.Equal("Hello", bundle.NewLocalizer("en").MustGet("Hello"))
+	assert.Len(failures, 1)
+	assert.ErrorIs(failures[0], ErrMissingMessage)
+}
+
+func TestTokenGetPlural(t *testing.T) {
+	assert := assert.New(t)
+	bundle := NewBundle(WithDefaultLocale("en"), WithLocales("en", "ru"))
+	assert.NoError(bundle.LoadMessages(map[string]map[string]string{
+		"en": {
+			"files":        "{count, plural, one {# file} other {# files}} in {dir}",
+			"apples.one":   "One apple",
+			"apples.other": "{count} apples",
+		},
+		"ru": {
+			"apples.one":  "{count} яблоко",
+			"apples.few":  "{count} яблока",
+			"apples.many": "{count} яблок",
+		},
+	}))
+	en := bundle.NewLocalizer("en")
+	ru := bundle.NewLocalizer("ru")
+
+	assert.Equal("1 file in docs", en.GetPlural("files", 1, Vars{"dir": "docs"}))
+	assert.Equal("3 files in docs", en.GetPlural("files", 3, Vars{"dir": "docs"}))
+	assert.Equal("One apple", en.GetPlural("apples", 1))
+	assert.Equal("5 apples", en.GetPlural("apples", 5))
+	assert.Equal("21 яблоко", ru.GetPlural("apples", 21))
+	assert.Equal("3 яблока", ru.GetPlural("apples", 3))
+	assert.Equal("5 яблок", ru.GetPlural("apples", 5))
+	assert.Equal("1.5 apples", ru.GetPlural("apples", 1.5))
+}
+
+func TestTokenWithVars(t *testing.T) {
+	assert := assert.New(t)
+	localizer := newTestLocalizer()
+	yami := localizer.WithVars(Vars{"Name": "Yami"})
+
+	assert.Equal("你好，Yami！", yami.Get("test_template"))
+	assert.Equal("你好，Kai！", yami.Get("test_template", Vars{"Name": "Kai"}))
+	assert.Equal("你好，Kai！", yami.WithVars(Vars{"Name": "Kai"}).Get("test_template"))
+	assert.Equal("这是一则测试讯息。", yami.Get("test_message"))
+	assert.Equal("你好，{Name}！", localizer.Get("test_template"))
+}
+
+func TestTokenBytes(t *testing.T) {
+	assert := assert.New(t)
+	localizer := newTestLocalizer()
+
+	assert.Equal([]byte("这是一则测试讯息。"), localizer.GetBytes("test_message"))
+	assert.Equal("<p>你好，Yami！", string(localizer.AppendTo([]byte("<p>"), "test_template", Vars{
+		"Name": "Yami",
+	})))
+
+	var buf bytes.Buffer
+	n, err := localizer.WriteTo(&buf, "not_exists_message")
+	assert.NoError(err)
+	assert.Equal(len("not_exists_message"), n)
+	assert.Equal("not_exists_message", buf.String())
+}
+
+func TestTokenMany(t *testing.T) {
+	assert := assert.New(t)
+	localizer := newTestLocalizer()
+
+	assert.Equal(map[string]string{
+		"test_message":       "这是一则测试讯息。",
+		"test_template":      "你好，Yami！",
+		"not_exists_message": "not_exists_message",
+	}, localizer.GetMany([]string{"test_message", "test_template", "not_exists_message"}, Vars{
+		"Name": "Yami",
+	}))
+
+	var names []string
+	for name, text := range localizer.GetSeq([]string{"test_message", "Post <verb>", "test_template"}) {
+		names = append(names, name)
+		if text == "发表贴文" {
+			break
+		}
+	}
+	assert.Equal([]string{"test_message", "Post <verb>"}, names)
+}
+
 func TestTokenPlural(t *testing.T) {
 	assert := assert.New(t)
 	localizer := newTestLocalizer()
@@ -155,6 +281,21 @@ func TestTextStringContext(t *testing.T) {
 	assert.Equal("文章", localizer.GetX("Post", "noun"))
 }
 
+func TestLookupAllocations(t *testing.T) {
+	assert := assert.New(t)
+	localizer := newTestLocalizer()
+
+	assert.Zero(testing.AllocsPerRun(100, func() {
+		_ = localizer.Get("test_message")
+	}))
+	assert.Zero(testing.AllocsPerRun(100, func() {
+		_ = localizer.GetX("Post", "verb")
+	}))
+	assert.Zero(testing.AllocsPerRun(100, func() {
+		_ = localizer.localizeVars(nil)
+	}))
+}
+
 func TestTextPluralContext(t *testing.T) {
 	assert := assert.New(t)
 	localizer := newTestLocalizer()
@@ -240,3 +381,141 @@ func TestTextFallbackResursive(t *testing.T) {
 		"count": 1,
 	}))
 }
+
+func TestFallbackChain(t *testing.T) {
+	assert := assert.New(t)
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "en-GB", "zh-Hans", "zh-Hant", "zh", "ja-JP", "ko-KR"),
+		WithFallback(map[string][]string{
+			"zh-Hans": {"zh", "zh-Hant"},
+			"ja-JP":   {"ko-KR"},
+			"ko-KR":   {"zh-Hans", "ja-JP"},
+		}))
+	assert.NoError(bundle.LoadMessages(map[string]map[string]string{
+		"en":      {"hello": "Hello", "bye": "Bye", "thanks": "Thanks"},
+		"en-GB":   {"hello": "Hello"},
+		"zh":      {"hello": "你好"},
+		"zh-Hans": {},
+		"zh-Hant": {"bye": "再見"},
+		"ja-JP":   {},
+		"ko-KR":   {},
+	}))
+
+	assert.Equal([]string{"zh-Hans", "zh", "zh-Hant", "en"}, bundle.FallbackChain("zh-Hans"))
+	assert.Equal([]string{"ja-JP", "ko-KR", "zh-Hans", "zh", "zh-Hant", "en"}, bundle.FallbackChain("ja-JP"))
+	assert.Equal([]string{"en-GB", "en"}, bundle.FallbackChain("en-GB"))
+	assert.Equal([]string{"en"}, bundle.FallbackChain("fr"))
+	assert.Equal([]string{"en"}, bundle.FallbackChain("en"))
+
+	// The messages are looked up in the order of the chain.
+	localizer := bundle.NewLocalizer("ja-JP")
+	assert.Equal("你好", localizer.Get("hello"))
+	assert.Equal("再見", localizer.Get("bye"))
+	assert.Equal("Thanks", localizer.Get("thanks"))
+}
+
+func TestFallbackChainDefaultLocale(t *testing.T) {
+	assert := assert.New(t)
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "zh", "zh-Hans"),
+		WithFallback(map[string][]string{
+			"zh-Hans": {"zh"},
+		}))
+	assert.NoError(bundle.LoadMessages(map[string]map[string]string{
+		"en":      {"hello": "Hello", "thanks": "Thanks"},
+		"zh":      {"hello": "你好"},
+		"zh-Hans": {},
+	}))
+
+	// The default locale ends the chain after the explicit fallbacks, the messages missing from all of them
+	// are the ones of the default locale instead of missing.
+	assert.Equal([]string{"zh-Hans", "zh", "en"}, bundle.FallbackChain("zh-Hans"))
+	localizer := bundle.NewLocalizer("zh-Hans")
+	assert.Equal("你好", localizer.Get("hello"))
+	text, err := localizer.GetE("thanks")
+	assert.NoError(err)
+	assert.Equal("Thanks", text)
+}
+
+func TestParentFallbacks(t *testing.T) {
+	assert := assert.New(t)
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "en-GB", "en-AU", "zh", "zh-Hant", "zh-TW", "es", "es-419", "es-MX"),
+		WithFallback(map[string][]string{"en-AU": {"en"}}),
+		WithParentFallbacks(true),
+	)
+	assert.NoError(bundle.LoadMessages(map[string]map[string]string{
+		"en":      {"color": "color", "hello": "hello", "bye": "bye"},
+		"en-GB":   {"color": "colour"},
+		"en-AU":   {},
+		"zh":      {"hello": "你好", "bye": "再见"},
+		"zh-Hant": {"bye": "再見"},
+		"zh-TW":   {},
+		"es":      {"hello": "hola"},
+		"es-419":  {},
+		"es-MX":   {},
+	}))
+
+	assert.Equal([]string{"zh-TW", "zh-Hant", "zh", "en"}, bundle.FallbackChain("zh-TW"))
+	assert.Equal([]string{"es-MX", "es-419", "es", "en"}, bundle.FallbackChain("es-MX"))
+	assert.Equal([]string{"en-GB", "en"}, bundle.FallbackChain("en-GB"))
+	// The explicit fallbacks take precedence.
+	assert.Equal([]string{"en-AU", "en"}, bundle.FallbackChain("en-AU"))
+
+	localizer := bundle.NewLocalizer("zh-TW")
+	assert.Equal("再見", localizer.Get("bye"))
+	assert.Equal("你好", localizer.Get("hello"))
+	assert.Equal("color", localizer.Get("color"))
+	assert.Equal("hola", bundle.NewLocalizer("es-MX").Get("hello"))
+}
+
+func TestLookupHook(t *testing.T) {
+	assert := assert.New(t)
+
+	var events []LookupEvent
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "zh-Hans", "ja-JP", "ko-KR"),
+		WithLookupHook(func(e LookupEvent) {
+			events = append(events, e)
+		}),
+	)
+	bundle.LoadMessages(testTranslations)
+	localizer := bundle.NewLocalizer("zh-Hans")
+
+	localizer.Get("test_template", Vars{"Name": "Yami"})
+	localizer.GetX("Post", "verb")
+	localizer.Getf("not_exists_message")
+
+	assert.Equal([]LookupEvent{
+		{Locale: "zh-Hans", Name: "test_template", Vars: Vars{"Name": "Yami"}},
+		{Locale: "zh-Hans", Name: "Post", Context: "verb"},
+		{Locale: "zh-Hans", Name: "not_exists_message", Missing: true},
+	}, events)
+}
+
+func TestWithRuntimeParsing(t *testing.T) {
+	assert := assert.New(t)
+
+	var missing []LookupEvent
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "zh-Hans", "ja-JP", "ko-KR"),
+		WithRuntimeParsing(false),
+		WithMissingHandler(func(e LookupEvent) {
+			missing = append(missing, e)
+		}),
+	)
+	bundle.LoadMessages(testTranslations)
+	localizer := bundle.NewLocalizer("zh-Hans")
+
+	assert.Equal("你好，Yami！", localizer.Get("test_template", Vars{"Name": "Yami"}))
+	assert.Equal("I'm fine, thanks to {Name}!", localizer.Get("I'm fine, thanks to {Name}!", Vars{"Name": "Yami"}))
+	assert.Equal("Post", localizer.GetX("Post", "adjective"))
+	assert.Equal("not_exists_message", localizer.Getf("not_exists_message"))
+	assert.Zero(bundle.runtimeParsedTranslations.len())
+	assert.Len(missing, 3)
+}
@@ -0,0 +1,19 @@
+package i18n
+
+// N marks a message name for extraction without translating it, for names that are stored
+// in variables or tables and translated later:
+//
+//	var statuses = map[Status]string{
+//		StatusActive: i18n.N("status_active"),
+//	}
+//
+//	localizer.Get(statuses[status])
+func N(name string) string {
+	return name
+}
+
+// NX marks a message name with a context for extraction without translating it,
+// the returned name can be passed to `Localizer.Get` like `Localizer.GetX` would do.
+func NX(name, context string) string {
+	return withContext(name, context)
+}
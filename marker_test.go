@@ -0,0 +1,20 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarkers(t *testing.T) {
+	assert := assert.New(t)
+	localizer := newTestLocalizer()
+
+	labels := []string{N("test_message"), NX("Post", "verb"), NX("Post", "noun")}
+
+	assert.Equal("test_message", labels[0])
+	assert.Equal("Post <verb>", labels[1])
+	assert.Equal("这是一则测试讯息。", localizer.Get(labels[0]))
+	assert.Equal("发表贴文", localizer.Get(labels[1]))
+	assert.Equal("文章", localizer.Get(labels[2]))
+}
@@ -0,0 +1,193 @@
+package i18n
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+// MessageFunc is a message compiled to Go by `GenerateGo`, it's never parsed at runtime: it writes the message
+// with the vars, prepared like the vars of the parsed messages, to the writer of its locale.
+type MessageFunc func(w *MessageWriter, vars Vars)
+
+// MessageWriter writes a message formatted by a `MessageFunc` in the locale of its translation, with the same output
+// as the parsed message. The first error is kept and fails the message like the formatting errors of the parsed ones.
+type MessageWriter struct {
+	strings.Builder
+	tag    language.Tag
+	plural PluralFunc
+	err    error
+}
+
+// Err returns the first error of the message, nil if there's none.
+func (w *MessageWriter) Err() error {
+	return w.err
+}
+
+// fail keeps the first error of the message.
+func (w *MessageWriter) fail(err error) {
+	if w.err == nil {
+		w.err = err
+	}
+}
+
+// WriteArg writes a simple argument like `{name}`, nothing if it's missing.
+func (w *MessageWriter) WriteArg(vars Vars, name string) {
+	s, err := argumentString(vars, name)
+	if err != nil {
+		w.fail(err)
+		return
+	}
+	w.WriteString(s)
+}
+
+// WritePound writes the value of `#` in a choice, returned by `Plural`, `Ordinal` or `Select`, or `#` itself if the
+// argument is missing.
+func (w *MessageWriter) WritePound(pound string) {
+	if pound == "" {
+		pound = "#"
+	}
+	w.WriteString(pound)
+}
+
+// WriteFormatted writes a typed argument like `{when, date, long}`.
+func (w *MessageWriter) WriteFormatted(vars Vars, name, typ, style string) {
+	format, ok := argumentFormatters[typ]
+	if !ok {
+		w.fail(fmt.Errorf("UnknownType: `%s`", typ))
+		return
+	}
+	s, err := format(w.tag, vars[name], style)
+	if err != nil {
+		w.fail(fmt.Errorf("%s: %w", name, err))
+		return
+	}
+	w.WriteString(s)
+}
+
+// Select returns the key of the choice of a select argument among the keys, `other` if none matches,
+// and the value of `#` in the choice.
+func (w *MessageWriter) Select(vars Vars, name string, keys ...string) (string, string) {
+	value, err := argumentString(vars, name)
+	if err != nil {
+		w.fail(err)
+		return "other", ""
+	}
+	return choiceKey(value, keys), value
+}
+
+// Plural returns the key of the choice of a plural argument among the keys: the exact match like `=0` of the count,
+// or the plural category of the count minus the offset, `other` if none matches. The value of `#` in the choice
+// is the count minus the offset, or the count itself for an exact match.
+func (w *MessageWriter) Plural(vars Vars, name string, offset int, keys ...string) (string, string) {
+	value, err := argumentString(vars, name)
+	if err != nil {
+		w.fail(err)
+		return "other", ""
+	}
+	v, ok := vars[name]
+	if !ok {
+		return "other", value
+	}
+
+	var exact string
+	switch t := v.(type) {
+	case int:
+		exact = "=" + strconv.Itoa(t)
+	case float64:
+		exact = "=" + strconv.FormatFloat(t, 'f', -1, 64)
+	case string:
+		exact = "=" + t
+	default:
+		w.fail(fmt.Errorf("Plural: Unsupported type for named key: %T", v))
+		return "other", value
+	}
+	if slices.Contains(keys, exact) {
+		return exact, value
+	}
+
+	n := v
+	if offset != 0 {
+		switch t := v.(type) {
+		case int:
+			n, value = t-offset, strconv.Itoa(t-offset)
+		case float64:
+			n, value = t-float64(offset), strconv.FormatFloat(t-float64(offset), 'f', -1, 64)
+		case string:
+			f, err := strconv.ParseFloat(t, 64)
+			if err != nil {
+				w.fail(err)
+				return "other", value
+			}
+			n, value = f-float64(offset), strconv.FormatFloat(f-float64(offset), 'f', -1, 64)
+		}
+	}
+	return choiceKey(w.plural(n, false), keys), value
+}
+
+// Ordinal returns the key of the choice of a selectordinal argument among the keys, the ordinal category of the
+// number or `other` if none matches, and the value of `#` in the choice.
+func (w *MessageWriter) Ordinal(vars Vars, name string, keys ...string) (string, string) {
+	value, err := argumentString(vars, name)
+	if err != nil {
+		w.fail(err)
+		return "other", ""
+	}
+	v, ok := vars[name]
+	if !ok {
+		return "other", value
+	}
+
+	switch t := v.(type) {
+	case int, float64:
+	case string:
+		if _, err := strconv.ParseFloat(t, 64); err != nil {
+			w.fail(err)
+			return "other", value
+		}
+	default:
+		w.fail(fmt.Errorf("Ordinal: Unsupported type for named key: %T", v))
+		return "other", value
+	}
+	return choiceKey(w.plural(v, true), keys), value
+}
+
+// choiceKey returns the key if it's one of the keys, `other` otherwise.
+func choiceKey(key string, keys []string) string {
+	if slices.Contains(keys, key) {
+		return key
+	}
+	return "other"
+}
+
+// argumentString returns the text of an argument like MessageFormat does, empty if it's missing.
+func argumentString(vars Vars, name string) (string, error) {
+	switch t := vars[name].(type) {
+	case nil:
+		return "", nil
+	case bool:
+		return strconv.FormatBool(t), nil
+	case string:
+		return t, nil
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return fmt.Sprint(t), nil
+	case float32:
+		return strconv.FormatFloat(float64(t), 'f', -1, 32), nil
+	case float64:
+		return strconv.FormatFloat(t, 'f', -1, 64), nil
+	case complex64, complex128:
+		return fmt.Sprintf("%g", t), nil
+	case uintptr:
+		return fmt.Sprintf("%08x", t), nil
+	case time.Duration:
+		return t.String(), nil
+	case fmt.Stringer:
+		return t.String(), nil
+	default:
+		return "", fmt.Errorf("toString: Unsupported type: %T", t)
+	}
+}
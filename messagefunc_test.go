@@ -0,0 +1,41 @@
+package i18n
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+)
+
+func TestMessageWriter(t *testing.T) {
+	assert := assert.New(t)
+
+	pluralFunc, ok := DefaultPluralRules.PluralFunc("en")
+	assert.True(ok)
+	w := &MessageWriter{tag: language.English, plural: pluralFunc}
+
+	key, pound := w.Plural(Vars{"n": 1}, "n", 0, "=1", "one")
+	assert.Equal([]string{"=1", "1"}, []string{key, pound})
+	key, pound = w.Plural(Vars{"n": "2"}, "n", 1, "one")
+	assert.Equal([]string{"one", "1"}, []string{key, pound})
+	key, pound = w.Plural(Vars{}, "n", 0, "one")
+	assert.Equal([]string{"other", ""}, []string{key, pound})
+	key, pound = w.Ordinal(Vars{"n": 22.0}, "n", "one", "two")
+	assert.Equal([]string{"two", "22"}, []string{key, pound})
+	key, pound = w.Select(Vars{"g": "female"}, "g", "male")
+	assert.Equal([]string{"other", "female"}, []string{key, pound})
+	assert.NoError(w.Err())
+
+	w.WriteArg(Vars{"d": 90 * time.Second}, "d")
+	w.WriteString(" ")
+	w.WritePound("")
+	w.WriteString(" ")
+	w.WriteFormatted(Vars{"n": 1234.5}, "n", "number", "")
+	assert.Equal("1m30s # 1,234.5", w.String())
+	assert.NoError(w.Err())
+
+	w.Plural(Vars{"n": int64(1)}, "n", 0)
+	w.WriteArg(Vars{"n": []int{}}, "n")
+	assert.EqualError(w.Err(), "Plural: Unsupported type for named key: int64")
+}
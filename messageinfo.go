@@ -0,0 +1,224 @@
+package i18n
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/language"
+)
+
+// ErrInvalidMessage is returned when a message is not a valid ICU MessageFormat.
+var ErrInvalidMessage = errors.New("i18n: invalid message")
+
+// MessageArgument describes an argument of a message, e.g. `{count, plural, offset:1 =0 {...} other {...}}`.
+type MessageArgument struct {
+	// Name is the name of the argument in the `Vars`.
+	Name string
+	// Type is `plural`, `selectordinal`, `select`, or empty for a simple argument like `{name}`.
+	Type string
+	// Offset is the `offset:` of a plural argument, `#` renders the count minus the offset
+	// and the plural category is selected for it, while the exact matches (`=1`) use the count itself.
+	Offset int
+	// Choices are the keys of the choices in order, e.g. `=0`, `one`, `other`.
+	Choices []string
+}
+
+// MessageInfo describes a message of a locale.
+type MessageInfo struct {
+	// Locale is the locale of the text, which differs from the requested one if the message comes from a fallback.
+	Locale string
+	// Name is the name of the message.
+	Name string
+	// Text is the raw text of the message.
+	Text string
+	// Arguments are the arguments of the message in order, including the nested ones.
+	Arguments []MessageArgument
+}
+
+// MessageInfo returns the description of a message of the locale, false if the message or the locale is not found.
+func (bundle *I18n) MessageInfo(locale, name string) (MessageInfo, bool) {
+	bundle.mu.RLock()
+	trans, ok := bundle.parsedTranslations[bundle.getExactSupportedLocale(locale)][name]
+	bundle.mu.RUnlock()
+	if !ok {
+		return MessageInfo{}, false
+	}
+
+	args, _ := parseMessageArguments(trans.text)
+	return MessageInfo{
+		Locale:    trans.locale,
+		Name:      trans.name,
+		Text:      trans.text,
+		Arguments: args,
+	}, true
+}
+
+// HasTranslation indicates whether a message is in the catalogs of the locale, including its fallbacks.
+// Unlike a lookup, the name is never parsed nor cached as a text-based translation.
+func (bundle *I18n) HasTranslation(locale, name string) bool {
+	bundle.mu.RLock()
+	defer bundle.mu.RUnlock()
+
+	_, ok := bundle.parsedTranslations[bundle.getExactSupportedLocale(locale)][name]
+	return ok
+}
+
+// ValidateMessage checks that the text is a valid ICU MessageFormat, e.g. that every plural
+// argument has an `other` choice and a non-negative `offset:`.
+func ValidateMessage(text string) error {
+	parser, err := newMessageParser(language.English)
+	if err != nil {
+		return err
+	}
+	if _, err := parser.Parse(text); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidMessage, err)
+	}
+	if _, err := parseMessageArguments(text); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidMessage, err)
+	}
+	return nil
+}
+
+// parseMessageArguments returns the arguments of a message.
+func parseMessageArguments(text string) ([]MessageArgument, error) {
+	s := &argumentScanner{input: []rune(text)}
+	if err := s.scanText(false); err != nil {
+		return s.args, err
+	}
+	return s.args, nil
+}
+
+// argumentScanner
+type argumentScanner struct {
+	input []rune
+	pos   int
+	args  []MessageArgument
+}
+
+// scanText scans a text until its end, or until the closing brace of a choice if nested.
+func (s *argumentScanner) scanText(nested bool) error {
+	for s.pos < len(s.input) {
+		switch s.input[s.pos] {
+		case '\\':
+			s.pos += 2
+		case '{':
+			s.pos++
+			if err := s.scanArgument(); err != nil {
+				return err
+			}
+		case '}':
+			if nested {
+				s.pos++
+				return nil
+			}
+			return fmt.Errorf("unbalanced braces at %d", s.pos)
+		default:
+			s.pos++
+		}
+	}
+	if nested {
+		return errors.New("unbalanced braces")
+	}
+	return nil
+}
+
+// scanArgument scans an argument after its opening brace.
+func (s *argumentScanner) scanArgument() error {
+	name, end := s.readUntil(",}")
+	arg := MessageArgument{Name: name}
+	if end == '}' {
+		s.args = append(s.args, arg)
+		return nil
+	}
+	typ, end := s.readUntil(",}")
+	arg.Type = typ
+	i := len(s.args)
+	s.args = append(s.args, arg)
+	if end == '}' {
+		return nil
+	}
+	switch typ {
+	case "plural", "selectordinal", "select":
+	default:
+		// Other types like `number` have a style, not choices.
+		_, end = s.readUntil("}")
+		if end != '}' {
+			return fmt.Errorf("unbalanced braces in %q", name)
+		}
+		return nil
+	}
+
+	for {
+		s.skipSpaces()
+		if s.pos >= len(s.input) {
+			return fmt.Errorf("unbalanced braces in %q", name)
+		}
+		if s.input[s.pos] == '}' {
+			s.pos++
+			return nil
+		}
+		key := s.readKey()
+		if key == "" {
+			return fmt.Errorf("missing choice in %q", name)
+		}
+		if strings.HasPrefix(key, "offset:") {
+			offset, err := strconv.Atoi(strings.TrimPrefix(key, "offset:"))
+			if err != nil || offset < 0 {
+				return fmt.Errorf("invalid offset in %q", name)
+			}
+			s.args[i].Offset = offset
+			continue
+		}
+		s.args[i].Choices = append(s.args[i].Choices, key)
+		s.skipSpaces()
+		if s.pos >= len(s.input) || s.input[s.pos] != '{' {
+			return fmt.Errorf("missing choice text in %q", name)
+		}
+		s.pos++
+		if err := s.scanText(true); err != nil {
+			return err
+		}
+	}
+}
+
+// readUntil reads a trimmed token until one of the delimiters, which is consumed and returned.
+func (s *argumentScanner) readUntil(delims string) (string, rune) {
+	start := s.pos
+	for s.pos < len(s.input) {
+		r := s.input[s.pos]
+		if strings.ContainsRune(delims, r) {
+			s.pos++
+			return strings.TrimSpace(string(s.input[start : s.pos-1])), r
+		}
+		s.pos++
+	}
+	return strings.TrimSpace(string(s.input[start:])), 0
+}
+
+// readKey reads a choice key like `=0` or `other`, `offset: 1` is read as `offset:1`.
+func (s *argumentScanner) readKey() string {
+	start := s.pos
+	for s.pos < len(s.input) && !unicode.IsSpace(s.input[s.pos]) && s.input[s.pos] != '{' && s.input[s.pos] != '}' {
+		s.pos++
+	}
+	key := string(s.input[start:s.pos])
+	if key == "offset:" {
+		s.skipSpaces()
+		start = s.pos
+		for s.pos < len(s.input) && !unicode.IsSpace(s.input[s.pos]) && s.input[s.pos] != '{' && s.input[s.pos] != '}' {
+			s.pos++
+		}
+		key += string(s.input[start:s.pos])
+	}
+	return key
+}
+
+// skipSpaces
+func (s *argumentScanner) skipSpaces() {
+	for s.pos < len(s.input) && unicode.IsSpace(s.input[s.pos]) {
+		s.pos++
+	}
+}
@@ -0,0 +1,102 @@
+package i18n
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPluralOffset(t *testing.T) {
+	assert := assert.New(t)
+
+	bundle := NewBundle(WithDefaultLocale("en"))
+	bundle.LoadMessages(map[string]map[string]string{
+		"en": {
+			"likes": "{count, plural, offset:1 =0 {Nobody liked this} =1 {You liked this} one {You and # other liked this} other {You and # others liked this}}",
+		},
+	})
+
+	localizer := bundle.NewLocalizer("en")
+	assert.Equal("Nobody liked this", localizer.Get("likes", Vars{"count": 0}))
+	assert.Equal("You liked this", localizer.Get("likes", Vars{"count": 1}))
+	assert.Equal("You and 1 other liked this", localizer.Get("likes", Vars{"count": 2}))
+	assert.Equal("You and 4 others liked this", localizer.Get("likes", Vars{"count": 5}))
+}
+
+func TestMessageInfo(t *testing.T) {
+	assert := assert.New(t)
+
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "zh-Hans"),
+	)
+	bundle.LoadMessages(map[string]map[string]string{
+		"en": {
+			"likes":    "{name} and {count, plural, offset: 1 =1 {you} one {# other} other {# others}} liked {gender, select, male {his} female {her} other {their \\{post\\}}} post",
+			"greeting": "Hello",
+		},
+		"zh-Hans": {
+			"greeting": "你好",
+		},
+	})
+
+	info, ok := bundle.MessageInfo("zh-Hans", "likes")
+	assert.True(ok)
+	assert.Equal("en", info.Locale)
+	assert.Equal([]MessageArgument{
+		{Name: "name"},
+		{Name: "count", Type: "plural", Offset: 1, Choices: []string{"=1", "one", "other"}},
+		{Name: "gender", Type: "select", Choices: []string{"male", "female", "other"}},
+	}, info.Arguments)
+
+	info, ok = bundle.MessageInfo("zh-Hans", "greeting")
+	assert.True(ok)
+	assert.Equal(MessageInfo{Locale: "zh-Hans", Name: "greeting", Text: "你好"}, info)
+
+	_, ok = bundle.MessageInfo("en", "unknown")
+	assert.False(ok)
+}
+
+func TestValidateMessage(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.NoError(ValidateMessage("{count, plural, offset:1 one {# other} other {# others}}"))
+	assert.NoError(ValidateMessage("{n, selectordinal, one {#st} two {#nd} few {#rd} other {#th}}"))
+	for _, text := range []string{
+		"{count, plural, offset:-1 one {#} other {#}}",
+		"{count, plural, offset: other {#}}",
+		"{count, plural, one {#}}",
+		"{name",
+	} {
+		assert.True(errors.Is(ValidateMessage(text), ErrInvalidMessage), text)
+	}
+}
+
+func TestHasTranslation(t *testing.T) {
+	assert := assert.New(t)
+
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "zh-Hans"),
+	)
+	assert.NoError(bundle.LoadMessages(map[string]map[string]string{
+		"en":      {"greeting": "Hello", "bye": "Bye"},
+		"zh-Hans": {"greeting": "你好"},
+	}))
+	assert.NoError(bundle.SetTenantOverrides("acme", map[string]map[string]string{
+		"zh-Hans": {"welcome": "欢迎"},
+	}))
+
+	assert.True(bundle.HasTranslation("zh_hans", "greeting"))
+	assert.True(bundle.HasTranslation("zh-Hans", "bye"))
+	assert.False(bundle.HasTranslation("zh-Hans", "Hello, world"))
+	assert.False(bundle.HasTranslation("fr", "greeting"))
+
+	localizer := bundle.NewLocalizer("zh-Hans")
+	assert.True(localizer.Has("greeting"))
+	assert.False(localizer.Has("welcome"))
+	assert.True(localizer.ForTenant("acme").Has("welcome"))
+	assert.False(localizer.Has("Hello, world"))
+	assert.Zero(bundle.runtimeParsedTranslations.len())
+}
@@ -0,0 +1,219 @@
+package i18n
+
+import (
+	"context"
+	"log/slog"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// maxSuggestions is the number of suggestions given for a missing message.
+const maxSuggestions = 3
+
+// maxTrackedMissing is the number of missing messages tracked by the rate limiter before the expired ones are dropped.
+const maxTrackedMissing = 10000
+
+// WithDebug enables the development mode: missing messages come with "did you mean" suggestions
+// of the nearest existing names in `LookupEvent.Suggestions`, for the missing handlers and the logs.
+func WithDebug(debug bool) func(*I18n) {
+	return func(bundle *I18n) {
+		bundle.debug = debug
+	}
+}
+
+// WithMissingHandler registers a handler that is called when a message is not found
+// in the locale and its fallbacks. The handler must be safe for concurrent use.
+func WithMissingHandler(handler func(LookupEvent)) func(*I18n) {
+	return func(bundle *I18n) {
+		bundle.missingHandlers = append(bundle.missingHandlers, handler)
+	}
+}
+
+// WithMissingMarker renders the messages that are not found in the locale and its fallbacks through the marker,
+// so the testers of the QA builds can spot the untranslated strings. The marker is given the name without
+// the context and the text that would be rendered otherwise:
+//
+//	i18n.WithMissingMarker(func(name, text string) string {
+//		return "⟦missing:" + name + "⟧"
+//	})
+func WithMissingMarker(marker func(name, text string) string) func(*I18n) {
+	return func(bundle *I18n) {
+		bundle.missingMarker = marker
+	}
+}
+
+// WithReturnKeyOnMissing controls whether the messages that are not found in the locale and its fallbacks
+// render their names, or the text-based translations parsed from them (enabled by default). Disable it for the APIs
+// that must never leak the internal names to the users: the missing messages render as empty strings, and `GetE`
+// still returns `ErrMissingMessage`.
+func WithReturnKeyOnMissing(enabled bool) func(*I18n) {
+	return func(bundle *I18n) {
+		bundle.hideMissingKeys = !enabled
+	}
+}
+
+// WithLogger logs the missing messages to the logger as warnings.
+func WithLogger(logger *slog.Logger) func(*I18n) {
+	return func(bundle *I18n) {
+		bundle.logger = logger
+	}
+}
+
+// WithMissingReportInterval deduplicates the reports of the missing messages: a message of a locale
+// is reported to the missing handlers and the logger at most once per interval, so a hot path with a broken name
+// doesn't flood them. The dropped reports are counted in `LookupEvent.Suppressed`. Lookup hooks are not limited.
+func WithMissingReportInterval(interval time.Duration) func(*I18n) {
+	return func(bundle *I18n) {
+		bundle.missingLimiter = &missingLimiter{
+			interval: interval,
+			now:      time.Now,
+			reports:  make(map[missingKey]*missingReport),
+		}
+	}
+}
+
+// missingKey identifies a missing message.
+type missingKey struct {
+	locale, name, context string
+}
+
+// missingReport
+type missingReport struct {
+	at         time.Time
+	suppressed int
+}
+
+// missingLimiter rate-limits the missing reports per message and locale.
+type missingLimiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	now      func() time.Time
+	reports  map[missingKey]*missingReport
+}
+
+// allow indicates whether the missing message can be reported now, and how many reports were suppressed before.
+func (l *missingLimiter) allow(event LookupEvent) (bool, int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	key := missingKey{event.Locale, event.Name, event.Context}
+	if report, ok := l.reports[key]; ok && now.Sub(report.at) < l.interval {
+		report.suppressed++
+		return false, 0
+	} else if ok {
+		suppressed := report.suppressed
+		report.at, report.suppressed = now, 0
+		return true, suppressed
+	}
+
+	if len(l.reports) >= maxTrackedMissing {
+		for k, report := range l.reports {
+			if now.Sub(report.at) >= l.interval {
+				delete(l.reports, k)
+			}
+		}
+		if len(l.reports) >= maxTrackedMissing {
+			l.reports = make(map[missingKey]*missingReport)
+		}
+	}
+	l.reports[key] = &missingReport{at: now}
+	return true, 0
+}
+
+// reportsMissing indicates whether the missing messages are reported at all.
+func (bundle *I18n) reportsMissing() bool {
+	return len(bundle.missingHandlers) > 0 || bundle.logger != nil
+}
+
+// reportMissing reports a missing message to the handlers and the logger, once allowed by the rate limiter.
+func (bundle *I18n) reportMissing(event LookupEvent) {
+	for _, handler := range bundle.missingHandlers {
+		handler(event)
+	}
+	if bundle.logger == nil {
+		return
+	}
+	attrs := []slog.Attr{
+		slog.String("locale", event.Locale),
+		slog.String("name", event.Name),
+	}
+	if event.Context != "" {
+		attrs = append(attrs, slog.String("context", event.Context))
+	}
+	if len(event.Suggestions) > 0 {
+		attrs = append(attrs, slog.String("did_you_mean", strings.Join(event.Suggestions, ", ")))
+	}
+	if event.Suppressed > 0 {
+		attrs = append(attrs, slog.Int("suppressed", event.Suppressed))
+	}
+	bundle.logger.LogAttrs(context.Background(), slog.LevelWarn, "i18n: missing translation", attrs...)
+}
+
+// suggest returns the existing names nearest to the missing name in the locale and the default locale.
+func (bundle *I18n) suggest(locale, name string) []string {
+	bundle.mu.RLock()
+	defer bundle.mu.RUnlock()
+
+	type candidate struct {
+		name     string
+		distance int
+	}
+	var candidates []candidate
+	seen := make(map[string]bool)
+	maxDistance := len([]rune(name))/4 + 1
+
+	for _, translations := range []map[string]*parsedTranslation{
+		bundle.parsedTranslations[locale],
+		bundle.parsedTranslations[bundle.defaultLocale],
+	} {
+		for existing := range translations {
+			if seen[existing] {
+				continue
+			}
+			seen[existing] = true
+
+			distance := levenshtein(name, existing)
+			if distance > maxDistance && !strings.HasPrefix(existing, name) && !strings.HasPrefix(name, existing) {
+				continue
+			}
+			candidates = append(candidates, candidate{existing, distance})
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].distance != candidates[j].distance {
+			return candidates[i].distance < candidates[j].distance
+		}
+		return candidates[i].name < candidates[j].name
+	})
+
+	var suggestions []string
+	for i := 0; i < len(candidates) && i < maxSuggestions; i++ {
+		suggestions = append(suggestions, candidates[i].name)
+	}
+	return suggestions
+}
+
+// levenshtein returns the edit distance between a and b.
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
@@ -0,0 +1,192 @@
+package i18n
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+// MissingEntry is a missing message recorded by a `MissingSink`.
+type MissingEntry struct {
+	Locale  string `json:"locale"`
+	Name    string `json:"name"`
+	Context string `json:"context,omitempty"`
+	// Vars are the data of the first request, as a sample for the translators.
+	Vars Vars `json:"vars,omitempty"`
+}
+
+// MissingSink receives the missing messages, each message of a locale once, see `WithMissingSink`.
+type MissingSink interface {
+	Add(entry MissingEntry) error
+}
+
+// MissingSinkFunc adapts a function to a `MissingSink`.
+type MissingSinkFunc func(entry MissingEntry) error
+
+// Add
+func (fn MissingSinkFunc) Add(entry MissingEntry) error {
+	return fn(entry)
+}
+
+// WithMissingSink records every missing message to the sink, de-duplicated per locale and name,
+// giving the translators a work queue generated from the real traffic. Errors of the sink are logged to the logger.
+func WithMissingSink(sink MissingSink) func(*I18n) {
+	recorder := &missingRecorder{
+		sink: sink,
+		seen: make(map[missingKey]bool),
+	}
+	return func(bundle *I18n) {
+		bundle.missingSinks = append(bundle.missingSinks, sink)
+		bundle.missingHandlers = append(bundle.missingHandlers, func(event LookupEvent) {
+			if err := recorder.record(event); err != nil && bundle.logger != nil {
+				bundle.logger.LogAttrs(context.Background(), slog.LevelError, "i18n: failed to record the missing translation",
+					slog.String("locale", event.Locale),
+					slog.String("name", event.Name),
+					slog.String("error", err.Error()),
+				)
+			}
+		})
+	}
+}
+
+// missingRecorder de-duplicates the missing messages for a sink.
+type missingRecorder struct {
+	mu   sync.Mutex
+	sink MissingSink
+	seen map[missingKey]bool
+}
+
+// record
+func (r *missingRecorder) record(event LookupEvent) error {
+	key := missingKey{event.Locale, event.Name, event.Context}
+
+	r.mu.Lock()
+	if r.seen[key] || len(r.seen) >= maxTrackedMissing {
+		r.mu.Unlock()
+		return nil
+	}
+	r.seen[key] = true
+	r.mu.Unlock()
+
+	return r.sink.Add(MissingEntry{
+		Locale:  event.Locale,
+		Name:    event.Name,
+		Context: event.Context,
+		Vars:    event.Vars,
+	})
+}
+
+// missingFlushDelay is the delay of the writes of a `MissingFileSink` after a new entry.
+const missingFlushDelay = time.Second
+
+// NewMissingFileSink creates a sink that writes the missing messages of each locale to `missing.<locale>.json`
+// in the directory, the entries are keyed by the name (with the `<context>` suffix if any).
+// Existing files are extended, so the queue survives restarts.
+func NewMissingFileSink(dir string) *MissingFileSink {
+	return &MissingFileSink{
+		dir:     dir,
+		pending: make(map[string]map[string]json.RawMessage),
+	}
+}
+
+// MissingFileSink is the sink of `NewMissingFileSink`. The entries are written in the background a second after
+// the first new one, off the lookups; `Flush` writes them at once, and `Close` of the bundle flushes the sink.
+type MissingFileSink struct {
+	mu      sync.Mutex
+	dir     string
+	pending map[string]map[string]json.RawMessage // The entries to write, encoded, by locale and key.
+	timer   *time.Timer
+	err     error // The error of the last background write, returned by the next `Add`.
+}
+
+// Add buffers the entry, it fails if the entry cannot be encoded to JSON, e.g. with a func in its vars.
+func (s *MissingFileSink) Add(entry MissingEntry) error {
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	name := entry.Name
+	if entry.Context != "" {
+		name = withContext(name, entry.Context)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entries, ok := s.pending[entry.Locale]
+	if !ok {
+		entries = make(map[string]json.RawMessage)
+		s.pending[entry.Locale] = entries
+	}
+	if _, ok := entries[name]; !ok {
+		entries[name] = b
+	}
+	if s.timer == nil {
+		s.timer = time.AfterFunc(missingFlushDelay, func() {
+			if err := s.Flush(); err != nil {
+				s.mu.Lock()
+				s.err = err
+				s.mu.Unlock()
+			}
+		})
+	}
+	err, s.err = s.err, nil
+	return err
+}
+
+// Flush writes the buffered entries to the files.
+func (s *MissingFileSink) Flush() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.timer != nil {
+		s.timer.Stop()
+		s.timer = nil
+	}
+	var errs []error
+	for locale, entries := range s.pending {
+		if err := s.write(locale, entries); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		delete(s.pending, locale)
+	}
+	return errors.Join(errs...)
+}
+
+// write adds the entries of a locale to its file, the entries already in the file are kept.
+func (s *MissingFileSink) write(locale string, entries map[string]json.RawMessage) error {
+	path := filepath.Join(s.dir, "missing."+locale+".json")
+	existing := make(map[string]json.RawMessage)
+	b, err := os.ReadFile(path) //nolint:gosec
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	if err == nil {
+		if err := json.Unmarshal(b, &existing); err != nil {
+			return err
+		}
+	}
+	for name, entry := range entries {
+		if _, ok := existing[name]; !ok {
+			existing[name] = entry
+		}
+	}
+
+	b, err = json.MarshalIndent(existing, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, b, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
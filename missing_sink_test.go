@@ -0,0 +1,89 @@
+package i18n
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/goccy/go-json"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMissingSink(t *testing.T) {
+	assert := assert.New(t)
+
+	var entries []MissingEntry
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "zh-Hans", "ja-JP", "ko-KR"),
+		WithMissingSink(MissingSinkFunc(func(entry MissingEntry) error {
+			entries = append(entries, entry)
+			return nil
+		})),
+	)
+	bundle.LoadMessages(testTranslations)
+
+	for i := 0; i < 3; i++ {
+		bundle.NewLocalizer("zh-Hans").Get("new_feature", Vars{"count": i})
+		bundle.NewLocalizer("ja-JP").GetX("Post", "adjective")
+	}
+	assert.Equal([]MissingEntry{
+		{Locale: "zh-Hans", Name: "new_feature", Vars: Vars{"count": 0}},
+		{Locale: "ja-JP", Name: "Post", Context: "adjective"},
+	}, entries)
+}
+
+func TestMissingFileSink(t *testing.T) {
+	assert := assert.New(t)
+	dir := t.TempDir()
+
+	newBundle := func() *I18n {
+		bundle := NewBundle(
+			WithDefaultLocale("en"),
+			WithLocales("en", "zh-Hans", "ja-JP", "ko-KR"),
+			WithMissingSink(NewMissingFileSink(dir)),
+		)
+		bundle.LoadMessages(testTranslations)
+		return bundle
+	}
+
+	bundle := newBundle()
+	localizer := bundle.NewLocalizer("zh-Hans")
+	localizer.Get("new_feature", Vars{"name": "Yami"})
+	localizer.GetX("Post", "adjective")
+	localizer.Get("new_feature")
+	assert.NoError(bundle.Close())
+
+	// Restart.
+	bundle = newBundle()
+	bundle.NewLocalizer("zh-Hans").Get("another_feature")
+	assert.NoError(bundle.Close())
+
+	b, err := os.ReadFile(filepath.Join(dir, "missing.zh-Hans.json"))
+	assert.NoError(err)
+	var got map[string]MissingEntry
+	assert.NoError(json.Unmarshal(b, &got))
+	assert.Equal(map[string]MissingEntry{
+		"new_feature":      {Locale: "zh-Hans", Name: "new_feature", Vars: Vars{"name": "Yami"}},
+		"Post <adjective>": {Locale: "zh-Hans", Name: "Post", Context: "adjective"},
+		"another_feature":  {Locale: "zh-Hans", Name: "another_feature"},
+	}, got)
+}
+
+func TestMissingFileSinkInvalidVars(t *testing.T) {
+	assert := assert.New(t)
+	dir := t.TempDir()
+
+	sink := NewMissingFileSink(dir)
+	assert.Error(sink.Add(MissingEntry{Locale: "fr", Name: "broken", Vars: Vars{"fn": func() {}}}))
+	assert.NoError(sink.Add(MissingEntry{Locale: "fr", Name: "welcome"}))
+	_, err := os.Stat(filepath.Join(dir, "missing.fr.json"))
+	assert.True(os.IsNotExist(err), "written on the lookup path")
+	assert.NoError(sink.Flush())
+
+	b, err := os.ReadFile(filepath.Join(dir, "missing.fr.json"))
+	assert.NoError(err)
+	var got map[string]MissingEntry
+	assert.NoError(json.Unmarshal(b, &got))
+	assert.Equal(map[string]MissingEntry{"welcome": {Locale: "fr", Name: "welcome"}}, got)
+}
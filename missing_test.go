@@ -0,0 +1,209 @@
+package i18n
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMissingHandler(t *testing.T) {
+	assert := assert.New(t)
+
+	var missing []LookupEvent
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "zh-Hans", "ja-JP", "ko-KR"),
+		WithMissingHandler(func(e LookupEvent) {
+			missing = append(missing, e)
+		}),
+	)
+	bundle.LoadMessages(testTranslations)
+	localizer := bundle.NewLocalizer("zh-Hans")
+
+	localizer.Get("test_message")
+	localizer.Get("tset_message")
+	localizer.GetX("Post", "adjective")
+
+	assert.Equal([]LookupEvent{
+		{Locale: "zh-Hans", Name: "tset_message", Missing: true},
+		{Locale: "zh-Hans", Name: "Post", Context: "adjective", Missing: true},
+	}, missing)
+}
+
+func TestMissingMarker(t *testing.T) {
+	assert := assert.New(t)
+
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "zh-Hans", "ja-JP", "ko-KR"),
+		WithMissingMarker(func(name, text string) string {
+			return "⟦" + text + "⟧"
+		}),
+	)
+	bundle.LoadMessages(testTranslations)
+	localizer := bundle.NewLocalizer("zh-Hans")
+
+	assert.Equal("这是一则测试讯息。", localizer.Get("test_message"))
+	assert.Equal("⟦tset_message⟧", localizer.Get("tset_message"))
+	assert.Equal("⟦Hello, Yami⟧", localizer.Get("Hello, {name}", Vars{"name": "Yami"}))
+	assert.Equal("⟦100%⟧", localizer.Getf("%d%%", 100))
+
+	str, err := localizer.GetE("tset_message")
+	assert.ErrorIs(err, ErrMissingMessage)
+	assert.Equal("⟦tset_message⟧", str)
+	assert.Equal(map[string]string{"test_message": "这是一则测试讯息。", "tset_message": "⟦tset_message⟧"},
+		localizer.GetMany([]string{"test_message", "tset_message"}))
+}
+
+func TestReturnKeyOnMissing(t *testing.T) {
+	assert := assert.New(t)
+
+	var missing []string
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "zh-Hans", "ja-JP", "ko-KR"),
+		WithReturnKeyOnMissing(false),
+		WithMissingHandler(func(e LookupEvent) {
+			missing = append(missing, e.Name)
+		}),
+	)
+	bundle.LoadMessages(testTranslations)
+	localizer := bundle.NewLocalizer("zh-Hans")
+
+	assert.Equal("这是一则测试讯息。", localizer.Get("test_message"))
+	assert.Empty(localizer.Get("tset_message"))
+	assert.Empty(localizer.Get("Hello, {name}", Vars{"name": "Yami"}))
+	assert.Empty(localizer.GetX("Post", "adjective"))
+
+	str, err := localizer.GetE("tset_message")
+	assert.ErrorIs(err, ErrMissingMessage)
+	assert.Empty(str)
+	assert.Equal([]string{"tset_message", "Hello, {name}", "Post", "tset_message"}, missing)
+}
+
+func TestDebugSuggestions(t *testing.T) {
+	assert := assert.New(t)
+
+	var missing []LookupEvent
+	var logs bytes.Buffer
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "zh-Hans"),
+		WithDebug(true),
+		WithLogger(slog.New(slog.NewTextHandler(&logs, nil))),
+		WithMissingHandler(func(e LookupEvent) {
+			missing = append(missing, e)
+		}),
+	)
+	bundle.LoadMessages(map[string]map[string]string{
+		"en": {
+			"errors.auth.invalid": "Invalid credentials",
+			"errors.auth.expired": "Session expired",
+			"welcome_message":     "Welcome",
+			"goodbye_message":     "Goodbye",
+		},
+		"zh-Hans": {
+			"welcome_message": "欢迎",
+		},
+	})
+	localizer := bundle.NewLocalizer("zh-Hans")
+
+	assert.Equal("welcom_message", localizer.Get("welcom_message"))
+	localizer.Get("errors.auth")
+	localizer.Get("something else entirely")
+
+	assert.Len(missing, 3)
+	assert.Equal([]string{"welcome_message"}, missing[0].Suggestions)
+	assert.Equal([]string{"errors.auth.expired", "errors.auth.invalid"}, missing[1].Suggestions)
+	assert.Empty(missing[2].Suggestions)
+	assert.Contains(logs.String(), `level=WARN msg="i18n: missing translation" locale=zh-Hans name=welcom_message did_you_mean=welcome_message`)
+}
+
+func TestLevenshtein(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(0, levenshtein("hello", "hello"))
+	assert.Equal(1, levenshtein("helo", "hello"))
+	assert.Equal(2, levenshtein("tset", "test"))
+	assert.Equal(3, levenshtein("kitten", "sitting"))
+	assert.Equal(1, levenshtein("你好", "你们好"))
+	assert.Equal(5, levenshtein("", "hello"))
+}
+
+func TestMissingReportInterval(t *testing.T) {
+	assert := assert.New(t)
+
+	var missing, lookups []LookupEvent
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "zh-Hans"),
+		WithMissingReportInterval(time.Minute),
+		WithMissingHandler(func(e LookupEvent) {
+			missing = append(missing, e)
+		}),
+		WithLookupHook(func(e LookupEvent) {
+			lookups = append(lookups, e)
+		}),
+	)
+	now := time.Now()
+	bundle.missingLimiter.now = func() time.Time { return now }
+	bundle.LoadMessages(map[string]map[string]string{
+		"en":      {"hello": "Hello"},
+		"zh-Hans": {"hello": "你好"},
+	})
+
+	for i := 0; i < 100; i++ {
+		bundle.NewLocalizer("en").Get("helo")
+		bundle.NewLocalizer("zh-Hans").Get("helo")
+	}
+	assert.Len(lookups, 200)
+	assert.Equal([]LookupEvent{
+		{Locale: "en", Name: "helo", Missing: true},
+		{Locale: "zh-Hans", Name: "helo", Missing: true},
+	}, missing)
+
+	now = now.Add(time.Minute)
+	bundle.NewLocalizer("en").Get("helo")
+	assert.Len(missing, 3)
+	assert.Equal(99, missing[2].Suppressed)
+}
+
+func TestMissingReportIntervalSuggestions(t *testing.T) {
+	assert := assert.New(t)
+
+	var missing, lookups []LookupEvent
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithDebug(true),
+		WithMissingReportInterval(time.Minute),
+		WithMissingHandler(func(e LookupEvent) {
+			missing = append(missing, e)
+		}),
+		WithLookupHook(func(e LookupEvent) {
+			lookups = append(lookups, e)
+		}),
+	)
+	now := time.Now()
+	bundle.missingLimiter.now = func() time.Time { return now }
+	bundle.LoadMessages(map[string]map[string]string{"en": {"hello": "Hello"}})
+
+	for i := 0; i < 3; i++ {
+		bundle.NewLocalizer("en").Get("helo")
+	}
+	// The suggestions are only computed for the reports that are sent.
+	assert.Len(lookups, 3)
+	for _, e := range lookups {
+		assert.Empty(e.Suggestions)
+	}
+	assert.Len(missing, 1)
+	assert.Equal([]string{"hello"}, missing[0].Suggestions)
+
+	now = now.Add(time.Minute)
+	bundle.NewLocalizer("en").Get("helo")
+	assert.Len(missing, 2)
+	assert.Equal([]string{"hello"}, missing[1].Suggestions)
+	assert.Equal(2, missing[1].Suppressed)
+}
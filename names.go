@@ -0,0 +1,64 @@
+package i18n
+
+// NameWidth is the width of the month and weekday names, e.g. `January`, `Jan` and `J`.
+type NameWidth string
+
+// The name widths of CLDR.
+const (
+	NameWide        NameWidth = "wide"
+	NameAbbreviated NameWidth = "abbreviated"
+	NameNarrow      NameWidth = "narrow"
+)
+
+// MonthNames returns the names of the months from January in the locale, in the stand-alone context of the
+// date pickers and the calendar headers, e.g. `январь` and not `января` in `ru`. An unknown width is wide.
+// The months are the Islamic ones if the calendar of the localizer is `CalendarIslamic`.
+func (localizer *Localizer) MonthNames(width NameWidth) []string {
+	tag := localizer.tag()
+	data := lookupLocaleData(tag)
+
+	var names, standalone [12]string
+	switch width {
+	case NameAbbreviated:
+		names, standalone = data.abbrMonths, data.standaloneMonths[1]
+	case NameNarrow:
+		names = data.narrowMonths
+	default:
+		names, standalone = data.months, data.standaloneMonths[0]
+	}
+	if standalone[0] != "" {
+		names = standalone
+	}
+	if calendarOf(tag) == CalendarIslamic {
+		switch width {
+		case NameAbbreviated:
+			names = islamicAbbrMonths
+		case NameNarrow:
+			names = [12]string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12"}
+		default:
+			names = islamicMonths
+		}
+	}
+	return names[:]
+}
+
+// WeekdayNames returns the names of the weekdays from Sunday in the locale, in the stand-alone context, so
+// that they're indexed by `time.Weekday`. See `Bundle.WeekData` for the first day of the week of the locale.
+// An unknown width is wide.
+func (localizer *Localizer) WeekdayNames(width NameWidth) []string {
+	data := lookupLocaleData(localizer.tag())
+
+	var names [7]string
+	switch width {
+	case NameAbbreviated:
+		names = data.abbrWeekdays
+		if data.standaloneAbbrWeekdays[0] != "" {
+			names = data.standaloneAbbrWeekdays
+		}
+	case NameNarrow:
+		names = data.narrowWeekdays
+	default:
+		names = data.weekdays
+	}
+	return names[:]
+}
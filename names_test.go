@@ -0,0 +1,49 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMonthNames(t *testing.T) {
+	assert := assert.New(t)
+
+	bundle := newTestParseBundle()
+
+	en := bundle.NewLocalizer("en")
+	assert.Equal([]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"}, en.MonthNames(NameWide))
+	assert.Equal("Sep", en.MonthNames(NameAbbreviated)[8])
+	assert.Equal([]string{"J", "F", "M", "A", "M", "J", "J", "A", "S", "O", "N", "D"}, en.MonthNames(NameNarrow))
+	assert.Equal("January", en.MonthNames("")[0])
+
+	// The stand-alone names differ from the ones of the dates.
+	ru := bundle.NewLocalizer("ru")
+	assert.Equal("январь", ru.MonthNames(NameWide)[0])
+	assert.Equal("май", ru.MonthNames(NameAbbreviated)[4])
+	assert.Equal("Я", ru.MonthNames(NameNarrow)[0])
+	assert.Equal("Mär", bundle.NewLocalizer("de").MonthNames(NameAbbreviated)[2])
+	assert.Equal("März", bundle.NewLocalizer("de").MonthNames(NameWide)[2])
+
+	assert.Equal("3月", bundle.NewLocalizer("ja").MonthNames(NameWide)[2])
+	assert.Equal("Ramadan", en.WithCalendar(CalendarIslamic).MonthNames(NameWide)[8])
+
+	// The names are copies.
+	en.MonthNames(NameWide)[0] = "Foo"
+	assert.Equal("January", en.MonthNames(NameWide)[0])
+}
+
+func TestWeekdayNames(t *testing.T) {
+	assert := assert.New(t)
+
+	bundle := newTestParseBundle()
+
+	en := bundle.NewLocalizer("en")
+	assert.Equal([]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"}, en.WeekdayNames(NameWide))
+	assert.Equal([]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"}, en.WeekdayNames(NameAbbreviated))
+	assert.Equal([]string{"S", "M", "T", "W", "T", "F", "S"}, en.WeekdayNames(NameNarrow))
+
+	assert.Equal([]string{"So", "Mo", "Di", "Mi", "Do", "Fr", "Sa"}, bundle.NewLocalizer("de").WeekdayNames(NameAbbreviated))
+	assert.Equal("金曜日", bundle.NewLocalizer("ja").WeekdayNames(NameWide)[5])
+	assert.Equal("五", bundle.NewLocalizer("zh-Hans").WeekdayNames(NameNarrow)[5])
+}
@@ -0,0 +1,128 @@
+package i18n
+
+import (
+	"fmt"
+	"strconv"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/number"
+)
+
+// NumberOptions are the options of `FormatNumber` and `FormatPercent`.
+type NumberOptions struct {
+	// MinFractionDigits pads the fraction with zeros up to this number of digits.
+	MinFractionDigits int
+	// MaxFractionDigits rounds the fraction to this number of digits, at least `MinFractionDigits`.
+	MaxFractionDigits int
+	// NoGrouping removes the grouping separators, like the `,` of `1,234`.
+	NoGrouping bool
+}
+
+// FormatNumber formats a number with the grouping separator, decimal mark and digits of the locale,
+// e.g. `1,234.5` in `en` and `1.234,5` in `de`. The fraction is rounded to 3 digits if the options are nil.
+func (localizer *Localizer) FormatNumber(v any, opts *NumberOptions) string {
+	return formatNumber(localizer.tag(), v, opts, number.Decimal)
+}
+
+// FormatPercent formats a ratio as a percentage of the locale, e.g. `25%` in `en` and `25 %` in `fr` for 0.25.
+// The percentage is rounded to an integer if the options are nil.
+func (localizer *Localizer) FormatPercent(v any, opts *NumberOptions) string {
+	return formatNumber(localizer.tag(), v, opts, number.Percent)
+}
+
+// formatNumber formats a number with a `x/text/number` formatter, or with `fmt` if it's not a number.
+func formatNumber(tag language.Tag, v any, opts *NumberOptions, format func(any, ...number.Option) number.Formatter) string {
+	if !isNumber(v) {
+		return fmt.Sprint(v)
+	}
+	var options []number.Option
+	if opts != nil {
+		options = append(options,
+			number.MinFractionDigits(opts.MinFractionDigits),
+			number.MaxFractionDigits(max(opts.MaxFractionDigits, opts.MinFractionDigits)))
+		if opts.NoGrouping {
+			options = append(options, number.NoSeparator())
+		}
+	}
+	return message.NewPrinter(tag).Sprint(format(v, options...))
+}
+
+// isNumber reports whether a value is a number that `x/text/number` can format.
+func isNumber(v any) bool {
+	_, ok := float64Value(v)
+	return ok
+}
+
+// float64Value converts a number of any Go numeric type to a float64.
+func float64Value(v any) (float64, bool) {
+	switch v := v.(type) {
+	case int:
+		return float64(v), true
+	case int8:
+		return float64(v), true
+	case int16:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint:
+		return float64(v), true
+	case uint8:
+		return float64(v), true
+	case uint16:
+		return float64(v), true
+	case uint32:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+	return 0, false
+}
+
+// numberArgument returns the number of an argument: the Go numbers as is, and the decimal strings parsed,
+// like the ones `localizeVars` converts the unsigned, float32 and large int64 numbers to for the plural arguments.
+// The integers are parsed without a float64 to keep their precision.
+func numberArgument(v any) (any, bool) {
+	s, ok := v.(string)
+	if !ok {
+		return v, isNumber(v)
+	}
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return n, true
+	}
+	if n, err := strconv.ParseUint(s, 10, 64); err == nil {
+		return n, true
+	}
+	if n, err := strconv.ParseFloat(s, 64); err == nil {
+		return n, true
+	}
+	return v, false
+}
+
+// formatNumberArgument formats the value of a `{n, number}` argument,
+// with the `integer` and `percent` styles of MessageFormat and the `::compact-short` skeleton of ICU.
+func formatNumberArgument(tag language.Tag, value any, style string) (string, error) {
+	value, ok := numberArgument(value)
+	if !ok {
+		return "", fmt.Errorf("invalid number: %T", value)
+	}
+	n, _ := float64Value(value)
+	if isCompactStyle(style) {
+		return formatCompact(tag, n), nil
+	}
+	switch style {
+	case "":
+		return formatNumber(tag, value, nil, number.Decimal), nil
+	case "integer":
+		return formatNumber(tag, value, &NumberOptions{}, number.Decimal), nil
+	case "percent":
+		return formatNumber(tag, value, nil, number.Percent), nil
+	}
+	return "", fmt.Errorf("invalid number style: %s", style)
+}
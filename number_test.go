@@ -0,0 +1,109 @@
+package i18n
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatNumber(t *testing.T) {
+	assert := assert.New(t)
+	bundle := newTestParseBundle()
+
+	cases := []struct {
+		locale string
+		value  any
+		opts   *NumberOptions
+		want   string
+	}{
+		{"en", 1234567.891, nil, "1,234,567.891"},
+		{"en", 1234.5, &NumberOptions{MinFractionDigits: 2, MaxFractionDigits: 2}, "1,234.50"},
+		{"en", 1234.5, &NumberOptions{}, "1,234"},
+		{"en", 1234.5678, &NumberOptions{MaxFractionDigits: 1, NoGrouping: true}, "1234.6"},
+		{"en", -12345, nil, "-12,345"},
+		{"de", 1234.5, nil, "1.234,5"},
+		{"de-CH", 1234.5, nil, "1’234.5"},
+		{"fr", 1234.5, nil, "1\u00a0234,5"},
+		{"ar", 1234.5, nil, "١٬٢٣٤٫٥"},
+		{"en", "n/a", nil, "n/a"},
+	}
+	for _, c := range cases {
+		assert.Equal(c.want, bundle.NewLocalizer(c.locale).FormatNumber(c.value, c.opts), "%s %v", c.locale, c.value)
+	}
+}
+
+func TestFormatPercent(t *testing.T) {
+	assert := assert.New(t)
+	bundle := newTestParseBundle()
+
+	assert.Equal("26%", bundle.NewLocalizer("en").FormatPercent(0.256, nil))
+	assert.Equal("25.6%", bundle.NewLocalizer("en").FormatPercent(0.256, &NumberOptions{MaxFractionDigits: 1}))
+	assert.Equal("25,60\u00a0%", bundle.NewLocalizer("de").FormatPercent(0.256, &NumberOptions{MinFractionDigits: 2}))
+}
+
+func TestFormatNumberCalendar(t *testing.T) {
+	assert := assert.New(t)
+	bundle := newTestParseBundle()
+
+	// The calendar of the localizer doesn't change the numbers.
+	ja := bundle.NewLocalizer("ja-JP-u-ca-japanese")
+	assert.Equal("1,234.5", ja.FormatNumber(1234.5, nil))
+	assert.Equal("26%", ja.FormatPercent(0.256, nil))
+	assert.Equal(bundle.NewLocalizer("ja").FormatCompact(12345), ja.FormatCompact(12345))
+	n, err := ja.ParseNumber("1,234.5")
+	assert.NoError(err)
+	assert.InDelta(1234.5, n, 1e-9)
+}
+
+func TestNumberArguments(t *testing.T) {
+	assert := assert.New(t)
+
+	bundle := NewBundle(WithDefaultLocale("en"), WithLocales("en", "de"))
+	assert.NoError(bundle.LoadMessages(map[string]map[string]string{
+		"en": {"total": "{n, number} items, {n, number, integer} rounded, {ratio, number, percent} done"},
+		"de": {"total": "{n, number} Artikel, {n, number, integer} gerundet, {ratio, number, percent} erledigt"},
+	}))
+	vars := Vars{"n": 1234.5, "ratio": 0.5}
+
+	assert.Equal("1,234.5 items, 1,234 rounded, 50% done", bundle.NewLocalizer("en").Get("total", vars))
+	assert.Equal("1.234,5 Artikel, 1.234 gerundet, 50\u00a0% erledigt", bundle.NewLocalizer("de").Get("total", vars))
+
+	_, err := bundle.NewLocalizer("en").GetE("total", Vars{"n": "many", "ratio": 0.5})
+	assert.ErrorIs(err, ErrFormatMessage)
+}
+
+func TestNumberArgumentTypes(t *testing.T) {
+	assert := assert.New(t)
+
+	bundle := NewBundle(WithDefaultLocale("en"), WithLocales("en"))
+	assert.NoError(bundle.LoadMessages(map[string]map[string]string{
+		"en": {"total": "{n, number}"},
+	}))
+	localizer := bundle.NewLocalizer("en")
+
+	cases := []struct {
+		value any
+		want  string
+	}{
+		{int(1234), "1,234"},
+		{int8(-12), "-12"},
+		{int16(1234), "1,234"},
+		{int32(1234), "1,234"},
+		{int64(1234), "1,234"},
+		{int64(math.MaxInt64), "9,223,372,036,854,775,807"},
+		{uint(1234), "1,234"},
+		{uint8(123), "123"},
+		{uint16(1234), "1,234"},
+		{uint32(1234), "1,234"},
+		{uint64(math.MaxUint64), "18,446,744,073,709,551,615"},
+		{float32(1234.5), "1,234.5"},
+		{float64(1234.5), "1,234.5"},
+		{"1234.5", "1,234.5"},
+	}
+	for _, c := range cases {
+		s, err := localizer.GetE("total", Vars{"n": c.value})
+		assert.NoError(err, "%T", c.value)
+		assert.Equal(c.want, s, "%T", c.value)
+	}
+}
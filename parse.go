@@ -0,0 +1,308 @@
+package i18n
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/number"
+)
+
+// ErrInvalidNumber is returned when a string cannot be parsed as a number of the locale.
+var ErrInvalidNumber = errors.New("i18n: invalid number")
+
+// ErrInvalidDate is returned when a string cannot be parsed as a date of the locale.
+var ErrInvalidDate = errors.New("i18n: invalid date")
+
+// numberSymbols are the symbols of the numbers of a locale.
+type numberSymbols struct {
+	decimal string
+	group   string
+	digits  [10]rune
+	// primaryGroup is the number of digits of the last group of the integers, secondaryGroup of the other groups,
+	// e.g. 3 and 2 in `1,23,45,678` in `hi`.
+	primaryGroup, secondaryGroup int
+}
+
+// numberSymbolsCache caches the symbols by locale.
+var numberSymbolsCache sync.Map
+
+// lookupNumberSymbols derives the symbols of a locale from the numbers formatted by `x/text`.
+func lookupNumberSymbols(tag language.Tag) numberSymbols {
+	if symbols, ok := numberSymbolsCache.Load(tag); ok {
+		return symbols.(numberSymbols)
+	}
+	printer := message.NewPrinter(tag)
+	var symbols numberSymbols
+	for i := range symbols.digits {
+		symbols.digits[i], _ = firstRune(printer.Sprint(number.Decimal(i)))
+	}
+	// `1234.5` is formatted like `1,234.5`, the group separator follows the first digit,
+	// the decimal separator precedes the last one.
+	sample := []rune(printer.Sprint(number.Decimal(1234.5, number.NoSeparator())))
+	if len(sample) > 2 {
+		symbols.decimal = string(sample[len(sample)-2])
+	}
+	sample = []rune(printer.Sprint(number.Decimal(1234)))
+	if len(sample) > 4 {
+		symbols.group = string(sample[1 : len(sample)-3])
+	}
+	// The sizes of the groups are the ones of `1234567890`, like `1,234,567,890` or `1,23,45,67,890`.
+	symbols.primaryGroup, symbols.secondaryGroup = 3, 3
+	if symbols.group != "" {
+		groups := strings.Split(printer.Sprint(number.Decimal(1234567890)), symbols.group)
+		if n := len(groups); n > 2 {
+			symbols.primaryGroup = utf8.RuneCountInString(groups[n-1])
+			symbols.secondaryGroup = utf8.RuneCountInString(groups[n-2])
+		}
+	}
+	numberSymbolsCache.Store(tag, symbols)
+	return symbols
+}
+
+// firstRune returns the first rune of a string, false if it's empty.
+func firstRune(s string) (rune, bool) {
+	for _, r := range s {
+		return r, true
+	}
+	return 0, false
+}
+
+// ParseNumber parses a number formatted with the separators and digits of the locale,
+// e.g. `1.234,56` in `de` or `1 234,56` in `fr`. The group separators are optional but only accepted
+// between the groups of the integer part, so `12,34` and `1.234,56` are invalid in `en`.
+// Any space separates the groups of the locales grouping with a space, and the ASCII apostrophe
+// the ones grouping with `’` like `de-CH`.
+func (localizer *Localizer) ParseNumber(s string) (float64, error) {
+	symbols := lookupNumberSymbols(localizer.tag())
+	invalid := fmt.Errorf("%w: %q", ErrInvalidNumber, s)
+	group := firstRuneOf(symbols.group)
+
+	var b strings.Builder
+	// groups are the numbers of digits of the groups of the integer part, one if there is no group separator.
+	groups := []int{0}
+	digits, fraction := 0, false
+	for i, r := range strings.TrimSpace(s) {
+		switch {
+		case i == 0 && (r == '-' || r == '−'):
+			b.WriteByte('-')
+		case i == 0 && r == '+':
+		case string(r) == symbols.decimal:
+			if fraction {
+				return 0, invalid
+			}
+			fraction = true
+			b.WriteByte('.')
+		case string(r) == symbols.group || isSpaceSeparator(r) && isSpaceSeparator(group) ||
+			r == '\'' && (group == '’' || group == '\''):
+			if fraction || groups[len(groups)-1] == 0 {
+				return 0, invalid
+			}
+			groups = append(groups, 0)
+		default:
+			d := digitValue(r, symbols.digits)
+			if d < 0 {
+				return 0, invalid
+			}
+			b.WriteByte(byte('0' + d))
+			digits++
+			if !fraction {
+				groups[len(groups)-1]++
+			}
+		}
+	}
+	if digits == 0 || !validGroups(groups, symbols.primaryGroup, symbols.secondaryGroup) {
+		return 0, invalid
+	}
+	v, err := strconv.ParseFloat(b.String(), 64)
+	if err != nil {
+		return 0, invalid
+	}
+	return v, nil
+}
+
+// validGroups reports whether the sizes of the groups of an integer follow the grouping of the locale:
+// the last group has the primary size, the ones before the secondary size and the first one at most that.
+func validGroups(groups []int, primary, secondary int) bool {
+	n := len(groups)
+	if n == 1 {
+		return true
+	}
+	if groups[n-1] != primary || groups[0] < 1 || groups[0] > secondary {
+		return false
+	}
+	for _, size := range groups[1 : n-1] {
+		if size != secondary {
+			return false
+		}
+	}
+	return true
+}
+
+// firstRuneOf returns the first rune of a string, 0 if it's empty.
+func firstRuneOf(s string) rune {
+	r, _ := firstRune(s)
+	return r
+}
+
+// isSpaceSeparator reports whether a rune is a space used as a group separator (` `, NBSP, NNBSP...).
+func isSpaceSeparator(r rune) bool {
+	return unicode.Is(unicode.Zs, r)
+}
+
+// digitValue returns the value of a native digit, or -1.
+func digitValue(r rune, digits [10]rune) int {
+	for i, d := range digits {
+		if r == d {
+			return i
+		}
+	}
+	if unicode.IsDigit(r) {
+		// Decimal digits are contiguous in Unicode.
+		for zero := r; zero >= r-9; zero-- {
+			if unicode.IsDigit(zero) && !unicode.IsDigit(zero-1) {
+				return int(r - zero)
+			}
+		}
+	}
+	return -1
+}
+
+// ParseDate parses a date written with the month names or the numeric field order of the locale,
+// e.g. `5 janv. 2025` in `fr`, `January 5, 2025` in `en` or `05/01/2025` in `en-GB`.
+// The date is returned at midnight UTC.
+func (localizer *Localizer) ParseDate(s string) (time.Time, error) {
+	tag := localizer.tag()
+	data := lookupLocaleData(tag)
+	digits := lookupNumberSymbols(tag).digits
+
+	var numbers []int
+	month := 0
+	for _, field := range splitDateFields(s) {
+		if n, ok := parseDigits(field, digits); ok {
+			numbers = append(numbers, n)
+			continue
+		}
+		if isDateLiteral(field, data) {
+			continue
+		}
+		m := matchMonth(field, data)
+		if m == 0 || month != 0 {
+			return time.Time{}, fmt.Errorf("%w: %q", ErrInvalidDate, s)
+		}
+		month = m
+	}
+
+	var year, day int
+	switch {
+	case month != 0 && len(numbers) == 2:
+		// The year is the 4-digit field, or the last one.
+		year, day = numbers[1], numbers[0]
+		if numbers[0] > 31 {
+			year, day = numbers[0], numbers[1]
+		}
+	case month == 0 && len(numbers) == 3:
+		order := data.dateOrder
+		if numbers[0] > 31 {
+			order = "ymd"
+		}
+		for i, f := range order {
+			switch f {
+			case 'y':
+				year = numbers[i]
+			case 'm':
+				month = numbers[i]
+			case 'd':
+				day = numbers[i]
+			}
+		}
+	default:
+		return time.Time{}, fmt.Errorf("%w: %q", ErrInvalidDate, s)
+	}
+	if year < 100 {
+		year += 2000
+	}
+
+	t := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	if t.Year() != year || int(t.Month()) != month || t.Day() != day {
+		return time.Time{}, fmt.Errorf("%w: %q", ErrInvalidDate, s)
+	}
+	return t, nil
+}
+
+// splitDateFields splits a date into its numeric and alphabetic fields, the CJK date suffixes
+// (`年`, `月`, `日`...) and the punctuations are separators.
+func splitDateFields(s string) []string {
+	var fields []string
+	var field []rune
+	kind := 0 // 1 for digits, 2 for letters.
+	flush := func() {
+		if len(field) > 0 {
+			fields = append(fields, string(field))
+		}
+		field, kind = nil, 0
+	}
+	for _, r := range s {
+		switch {
+		case unicode.IsDigit(r):
+			if kind != 1 {
+				flush()
+			}
+			kind = 1
+		case unicode.IsLetter(r) && !unicode.Is(unicode.Han, r) && !unicode.Is(unicode.Hangul, r):
+			if kind != 2 {
+				flush()
+			}
+			kind = 2
+		case r == '.' && kind == 2:
+			// Abbreviations like `janv.` keep their period.
+		default:
+			flush()
+			continue
+		}
+		field = append(field, r)
+	}
+	flush()
+	return fields
+}
+
+// parseDigits parses a field made of ASCII or native digits.
+func parseDigits(field string, digits [10]rune) (int, bool) {
+	n := 0
+	for _, r := range field {
+		d := digitValue(r, digits)
+		if d < 0 {
+			return 0, false
+		}
+		n = n*10 + d
+	}
+	return n, field != ""
+}
+
+// matchMonth returns the month (1-12) named by a field, 0 if none.
+func matchMonth(field string, data *localeData) int {
+	field = strings.TrimSuffix(field, ".")
+	for i := range data.months {
+		if strings.EqualFold(field, data.months[i]) || strings.EqualFold(field, strings.TrimSuffix(data.abbrMonths[i], ".")) {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// isDateLiteral reports whether a field is a literal word of the date patterns.
+func isDateLiteral(field string, data *localeData) bool {
+	for _, literal := range data.dateLiterals {
+		if strings.EqualFold(strings.TrimSuffix(field, "."), strings.TrimSuffix(literal, ".")) {
+			return true
+		}
+	}
+	return false
+}
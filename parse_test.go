@@ -0,0 +1,112 @@
+package i18n
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestParseBundle() *I18n {
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "en-GB", "de", "fr", "ru", "ja", "zh-Hans", "ko", "ar", "de-CH"),
+	)
+	messages := make(map[string]map[string]string)
+	for _, tag := range bundle.SupportedLanguages() {
+		messages[tag.String()] = map[string]string{"hello": "hello"}
+	}
+	bundle.LoadMessages(messages)
+	return bundle
+}
+
+func TestParseNumber(t *testing.T) {
+	assert := assert.New(t)
+	bundle := newTestParseBundle()
+
+	cases := []struct {
+		locale string
+		input  string
+		want   float64
+	}{
+		{"en", "1,234.56", 1234.56},
+		{"en", "-42", -42},
+		{"de", "1.234,56", 1234.56},
+		{"fr", "1 234,56", 1234.56},
+		{"fr", "1 234,56", 1234.56},
+		{"ru", "1 234,5", 1234.5},
+		{"de-CH", "1’234.5", 1234.5},
+		{"de-CH", "1'234.56", 1234.56},
+		{"ar", "١٬٢٣٤٫٥", 1234.5},
+		{"en", "1234.5", 1234.5},
+		{"en", "1,234,567", 1234567},
+		{"en", "+.5", 0.5},
+		{"de", "1234,5", 1234.5},
+	}
+	for _, c := range cases {
+		v, err := bundle.NewLocalizer(c.locale).ParseNumber(c.input)
+		assert.NoError(err, c.input)
+		assert.Equal(c.want, v, c.input)
+	}
+
+	invalid := []struct {
+		locale string
+		input  string
+	}{
+		{"en", "12abc"},
+		{"de", ""},
+		{"en", "1,2,3"},
+		{"en", "12,34"},
+		{"en", "1.234,56"},
+		{"en", "1,234.5,6"},
+		{"en", "1.2.3"},
+		{"en", ",123"},
+		{"en", "1,,234"},
+		{"en", "1,234,"},
+		{"en", "1-2"},
+		{"en", "-"},
+		{"de", "1,234.56"},
+		{"fr", "1 23,5"},
+		{"de-CH", "1'23"},
+	}
+	for _, c := range invalid {
+		_, err := bundle.NewLocalizer(c.locale).ParseNumber(c.input)
+		assert.True(errors.Is(err, ErrInvalidNumber), c.input)
+	}
+}
+
+func TestParseDate(t *testing.T) {
+	assert := assert.New(t)
+	bundle := newTestParseBundle()
+	want := time.Date(2025, time.January, 5, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		locale string
+		input  string
+	}{
+		{"fr", "5 janv. 2025"},
+		{"fr", "5 janvier 2025"},
+		{"en", "January 5, 2025"},
+		{"en", "Jan 5, 2025"},
+		{"en", "1/5/2025"},
+		{"en-GB", "05/01/2025"},
+		{"de", "5. Januar 2025"},
+		{"de", "05.01.25"},
+		{"ru", "5 января 2025 г."},
+		{"ja", "2025年1月5日"},
+		{"zh-Hans", "2025/1/5"},
+		{"ko", "2025년 1월 5일"},
+		{"en", "2025-01-05"},
+	}
+	for _, c := range cases {
+		d, err := bundle.NewLocalizer(c.locale).ParseDate(c.input)
+		assert.NoError(err, c.input)
+		assert.Equal(want, d, c.input)
+	}
+
+	for _, input := range []string{"31/02/2025", "5 foo 2025", "2025"} {
+		_, err := bundle.NewLocalizer("en-GB").ParseDate(input)
+		assert.True(errors.Is(err, ErrInvalidDate), input)
+	}
+}
@@ -0,0 +1,180 @@
+package i18n
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/gotnospirit/makeplural/plural"
+	"golang.org/x/text/language"
+)
+
+// ErrUnsupportedNumber is returned when a value cannot be used as a plural operand.
+var ErrUnsupportedNumber = errors.New("i18n: unsupported number")
+
+// ErrUnsupportedLanguage is returned when the plural rules don't support a language.
+var ErrUnsupportedLanguage = errors.New("i18n: unsupported language")
+
+// PluralFunc returns the plural category (or the ordinal category if `ordinal` is true) of a number.
+// The number is an `int`, `int64`, `float64` or a decimal `string`.
+type PluralFunc func(n any, ordinal bool) string
+
+// PluralRules provides the plural functions of the languages, the default rules are `DefaultPluralRules`.
+type PluralRules interface {
+	// CLDRVersion returns the version of the CLDR data that the rules are generated from.
+	CLDRVersion() string
+	// PluralFunc returns the plural function of a base language (e.g. `en`), false if not supported.
+	PluralFunc(lang string) (PluralFunc, bool)
+}
+
+// DefaultPluralRules are the built-in plural rules.
+var DefaultPluralRules PluralRules = builtinPluralRules{}
+
+// builtinPluralRules are generated by `gotnospirit/makeplural` from CLDR 27 (revision 11229).
+type builtinPluralRules struct{}
+
+// CLDRVersion
+func (builtinPluralRules) CLDRVersion() string {
+	return "27"
+}
+
+// PluralFunc
+func (builtinPluralRules) PluralFunc(lang string) (PluralFunc, bool) {
+	fn, err := plural.GetFunc(lang)
+	if err != nil {
+		return nil, false
+	}
+	return fn, true
+}
+
+// NewPluralRules creates plural rules from the functions of the languages, the other languages
+// are resolved by the fallback rules (e.g. `DefaultPluralRules`) if not nil.
+// It allows opting into newer CLDR data for some languages independently of the library version.
+func NewPluralRules(cldrVersion string, funcs map[string]PluralFunc, fallback PluralRules) PluralRules {
+	return &customPluralRules{
+		version:  cldrVersion,
+		funcs:    funcs,
+		fallback: fallback,
+	}
+}
+
+// customPluralRules
+type customPluralRules struct {
+	version  string
+	funcs    map[string]PluralFunc
+	fallback PluralRules
+}
+
+// CLDRVersion
+func (r *customPluralRules) CLDRVersion() string {
+	return r.version
+}
+
+// PluralFunc
+func (r *customPluralRules) PluralFunc(lang string) (PluralFunc, bool) {
+	if fn, ok := r.funcs[lang]; ok {
+		return fn, true
+	}
+	if r.fallback != nil {
+		return r.fallback.PluralFunc(lang)
+	}
+	return nil, false
+}
+
+// WithPluralRules replaces the plural rules used by the messages and the plural APIs,
+// see `NewPluralRules`.
+func WithPluralRules(rules PluralRules) func(*I18n) {
+	return func(bundle *I18n) {
+		bundle.pluralRules = rules
+	}
+}
+
+// CLDRVersion returns the version of the CLDR data that the plural rules of the bundle come from.
+// Plural rule updates can change the rendered output, pin it in the tests if it matters.
+func (bundle *I18n) CLDRVersion() string {
+	return bundle.pluralRules.CLDRVersion()
+}
+
+// PluralCategory returns the CLDR plural category (`zero`, `one`, `two`, `few`, `many` or `other`)
+// of the number in the locale, the same way the `plural` argument of a message selects it.
+// The number can be any of the numbers accepted by the `plural` arguments: an integer, a float, a decimal
+// string like "1.50" or a decimal type whose `String` method returns one.
+func (bundle *I18n) PluralCategory(locale string, n any) (string, error) {
+	return bundle.pluralCategory(locale, n, false)
+}
+
+// OrdinalCategory returns the CLDR ordinal category of the number in the locale,
+// the same way the `selectordinal` argument of a message selects it.
+func (bundle *I18n) OrdinalCategory(locale string, n any) (string, error) {
+	return bundle.pluralCategory(locale, n, true)
+}
+
+// pluralCategory returns the plural or ordinal category of a number in a locale.
+func (bundle *I18n) pluralCategory(locale string, n any, ordinal bool) (string, error) {
+	tag, err := language.Parse(locale)
+	if err != nil {
+		return "", err
+	}
+	fn, err := bundle.pluralFunc(tag)
+	if err != nil {
+		return "", err
+	}
+	operand, ok := pluralOperand(n)
+	if !ok {
+		if s, isString := n.(string); isString {
+			return "", fmt.Errorf("%w: %q", ErrUnsupportedNumber, s)
+		}
+		return "", fmt.Errorf("%w: %T", ErrUnsupportedNumber, n)
+	}
+	return fn(operand, ordinal), nil
+}
+
+// pluralFunc returns the plural function of the base language of the tag.
+func (bundle *I18n) pluralFunc(tag language.Tag) (PluralFunc, error) {
+	base, _ := tag.Base()
+	fn, ok := bundle.pluralRules.PluralFunc(base.String())
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedLanguage, base)
+	}
+	return fn, nil
+}
+
+// pluralOperand converts a number to a type that the plural functions and the `plural` and `selectordinal`
+// arguments of the messages understand (`int`, `float64` or a decimal `string`), false if it's not a number.
+// Decimal types (e.g. `decimal.Decimal`) are converted by their `String` method, so the visible fraction
+// digits select the category like the ICU operands `v`, `w` and `f` do: `1.0` is not `one` in English.
+func pluralOperand(v any) (any, bool) {
+	switch n := v.(type) {
+	case int, float64:
+		return v, true
+	case int8:
+		return int(n), true
+	case int16:
+		return int(n), true
+	case int32:
+		return int(n), true
+	case int64:
+		if int64(int(n)) == n {
+			return int(n), true
+		}
+		return strconv.FormatInt(n, 10), true
+	case uint8:
+		return int(n), true
+	case uint16:
+		return int(n), true
+	case uint, uint32, uint64:
+		return fmt.Sprint(n), true
+	case float32:
+		return strconv.FormatFloat(float64(n), 'f', -1, 32), true
+	case string:
+		if _, err := strconv.ParseFloat(n, 64); err == nil {
+			return n, true
+		}
+	case fmt.Stringer:
+		s := n.String()
+		if _, err := strconv.ParseFloat(s, 64); err == nil {
+			return s, true
+		}
+	}
+	return v, false
+}
@@ -0,0 +1,159 @@
+package i18n
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPluralCategory(t *testing.T) {
+	assert := assert.New(t)
+	bundle := NewBundle(WithDefaultLocale("en"))
+
+	for _, c := range []struct {
+		locale string
+		n      any
+		want   string
+	}{
+		{"en", 1, "one"},
+		{"en", 2, "other"},
+		{"en", 1.5, "other"},
+		{"en", "1.0", "other"},
+		{"en-GB", int64(1), "one"},
+		{"fr", 1.5, "one"},
+		{"ru", 3, "few"},
+		{"ru", uint8(5), "many"},
+		{"ar", 0, "zero"},
+		{"zh-Hans", 1, "other"},
+	} {
+		category, err := bundle.PluralCategory(c.locale, c.n)
+		assert.NoError(err)
+		assert.Equal(c.want, category, "%s %v", c.locale, c.n)
+	}
+
+	_, err := bundle.PluralCategory("en", "abc")
+	assert.ErrorIs(err, ErrUnsupportedNumber)
+	_, err = bundle.PluralCategory("en", struct{}{})
+	assert.ErrorIs(err, ErrUnsupportedNumber)
+	_, err = bundle.PluralCategory("not a locale", 1)
+	assert.Error(err)
+}
+
+func TestOrdinalCategory(t *testing.T) {
+	assert := assert.New(t)
+	bundle := NewBundle(WithDefaultLocale("en"))
+
+	for n, want := range map[int]string{1: "one", 2: "two", 3: "few", 4: "other", 11: "other", 22: "two"} {
+		category, err := bundle.OrdinalCategory("en", n)
+		assert.NoError(err)
+		assert.Equal(want, category, "%d", n)
+	}
+	category, err := bundle.OrdinalCategory("fr", 1)
+	assert.NoError(err)
+	assert.Equal("one", category)
+}
+
+func TestPluralRules(t *testing.T) {
+	assert := assert.New(t)
+
+	bundle := NewBundle(WithDefaultLocale("en"))
+	assert.Equal("27", bundle.CLDRVersion())
+
+	// A made-up rule that gives `en` a `zero` category.
+	enPlural, _ := DefaultPluralRules.PluralFunc("en")
+	bundle = NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "fr"),
+		WithPluralRules(NewPluralRules("99", map[string]PluralFunc{
+			"en": func(n any, ordinal bool) string {
+				if !ordinal && n == 0 {
+					return "zero"
+				}
+				return enPlural(n, ordinal)
+			},
+		}, DefaultPluralRules)),
+	)
+	assert.Equal("99", bundle.CLDRVersion())
+	assert.NoError(bundle.LoadMessages(map[string]map[string]string{
+		"en": {"apples": "{count, plural, zero {no apples} one {# apple} other {# apples}}"},
+		"fr": {"apples": "{count, plural, one {# pomme} other {# pommes}}"},
+	}))
+
+	category, err := bundle.PluralCategory("en", 0)
+	assert.NoError(err)
+	assert.Equal("zero", category)
+	assert.Equal("no apples", bundle.NewLocalizer("en").Get("apples", Vars{"count": 0}))
+	assert.Equal("1 apple", bundle.NewLocalizer("en").Get("apples", Vars{"count": 1}))
+	assert.Equal("0 pomme", bundle.NewLocalizer("fr").Get("apples", Vars{"count": 0}))
+
+	bundle = NewBundle(
+		WithDefaultLocale("en"),
+		WithPluralRules(NewPluralRules("99", nil, nil)),
+	)
+	_, err = bundle.PluralCategory("en", 1)
+	assert.ErrorIs(err, ErrUnsupportedLanguage)
+	assert.ErrorIs(bundle.LoadMessages(map[string]map[string]string{"en": {"hello": "Hello"}}), ErrUnsupportedLanguage)
+}
+
+type testDecimal struct {
+	coef  int64
+	scale int
+}
+
+func (d testDecimal) String() string {
+	s := strconv.FormatInt(d.coef, 10)
+	return s[:len(s)-d.scale] + "." + s[len(s)-d.scale:]
+}
+
+func TestFractionalPlural(t *testing.T) {
+	assert := assert.New(t)
+
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "de"),
+	)
+	bundle.LoadMessages(map[string]map[string]string{
+		"en": {"hours": "{n, plural, one {# hour} other {# hours}}"},
+		"de": {"hours": "{n, plural, one {# Stunde} other {# Stunden}}"},
+	})
+
+	localizer := bundle.NewLocalizer("de")
+	cases := []struct {
+		n    any
+		want string
+	}{
+		{1, "1 Stunde"},
+		{int64(1), "1 Stunde"},
+		{uint8(1), "1 Stunde"},
+		{1.5, "1.5 Stunden"},
+		{float32(1.5), "1.5 Stunden"},
+		{"1.0", "1.0 Stunden"},
+		{testDecimal{150, 2}, "1.50 Stunden"},
+		{uint64(2), "2 Stunden"},
+	}
+	for _, c := range cases {
+		assert.Equal(c.want, localizer.Get("hours", Vars{"n": c.n}), "%T", c.n)
+	}
+	assert.Equal("1 hour", bundle.NewLocalizer("en").Get("hours", Vars{"n": int32(1)}))
+}
+
+func TestPluralOperandPaths(t *testing.T) {
+	assert := assert.New(t)
+
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en"),
+	)
+	bundle.LoadMessages(map[string]map[string]string{
+		"en": {"category": "{n, plural, one {one} other {other}}"},
+	})
+	localizer := bundle.NewLocalizer("en")
+
+	// The categories of PluralCategory are the ones selected by the messages.
+	for _, n := range []any{1, int8(1), int64(1), uint(1), uint64(1), float32(1), 1.0, 1.5, "1", "1.0", testDecimal{100, 2}, testDecimal{15, 1}} {
+		category, err := bundle.PluralCategory("en", n)
+		assert.NoError(err, "%T %v", n, n)
+		assert.Equal(localizer.Get("category", Vars{"n": n}), category, "%T %v", n, n)
+	}
+}
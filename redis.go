@@ -0,0 +1,136 @@
+package i18n
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// RedisClient is the subset of a Redis client used by `RedisLoader`, an adapter of the client in use
+// (e.g. go-redis) keeps the package free of the Redis dependencies.
+type RedisClient interface {
+	// HGetAll returns the fields of a hash, empty if the hash doesn't exist.
+	HGetAll(ctx context.Context, key string) (map[string]string, error)
+	// Subscribe delivers the messages published to the channel until the context is canceled.
+	Subscribe(ctx context.Context, channel string) (<-chan string, error)
+}
+
+// RedisLoader is a `Loader` that reads the translations from the Redis hashes of the locales (`i18n:en`),
+// the fields are the names. Multiple instances share the translations, and `Subscribe` applies the updates.
+// A loader must not be copied after its first use.
+type RedisLoader struct {
+	Client RedisClient
+	// Locales are the locales to read, each locale is a hash.
+	Locales []string
+	// KeyPrefix prefixes the hash of each locale, `i18n:` by default.
+	KeyPrefix string
+	// Channel is the channel `Subscribe` listens to, `i18n:invalidate` by default.
+	Channel string
+
+	mu sync.Mutex
+	// fields are the fields last read from the hash of each locale.
+	fields map[string][]string
+}
+
+// Load reads the hashes of the locales.
+func (loader *RedisLoader) Load(ctx context.Context) (map[string]map[string]string, error) {
+	data := make(map[string]map[string]string, len(loader.Locales))
+	for _, locale := range loader.Locales {
+		trans, err := loader.Client.HGetAll(ctx, loader.key(locale))
+		if err != nil {
+			return nil, err
+		}
+		data[locale] = trans
+		loader.setFields(locale, trans)
+	}
+	return data, nil
+}
+
+// Subscribe listens to the invalidation channel until the context is canceled, and reloads the hash of the published
+// locale into the bundle, all the locales if the message is empty. Publish to it after updating a hash.
+// The hash replaces the translations it provided: the fields deleted since the last read are removed from the bundle.
+// The failures are logged to the `WithLogger` logger.
+func (loader *RedisLoader) Subscribe(ctx context.Context, bundle *I18n) error {
+	messages, err := loader.Client.Subscribe(ctx, loader.channel())
+	if err != nil {
+		return err
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case locale, ok := <-messages:
+			if !ok {
+				return nil
+			}
+			locales := loader.Locales
+			if locale != "" {
+				locales = []string{locale}
+			}
+			for _, locale := range locales {
+				if err := loader.reload(ctx, bundle, locale); err != nil {
+					bundle.logLoadError(loader.key(locale), err)
+				}
+			}
+		}
+	}
+}
+
+// reload replaces the translations read from the hash of a locale in the bundle.
+func (loader *RedisLoader) reload(ctx context.Context, bundle *I18n, locale string) error {
+	trans, err := loader.Client.HGetAll(ctx, loader.key(locale))
+	if err != nil {
+		return err
+	}
+
+	var removed []string
+	loader.mu.Lock()
+	for _, name := range loader.fields[locale] {
+		if _, ok := trans[name]; !ok {
+			removed = append(removed, name)
+		}
+	}
+	loader.mu.Unlock()
+
+	err = bundle.replaceMessages(locale, trans, removed)
+	if errors.Is(err, ErrInvalidLocale) {
+		// The hashes of the unsupported locales are ignored like `LoadMessages` does.
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	loader.setFields(locale, trans)
+	return nil
+}
+
+// setFields remembers the fields read from the hash of a locale.
+func (loader *RedisLoader) setFields(locale string, trans map[string]string) {
+	fields := make([]string, 0, len(trans))
+	for name := range trans {
+		fields = append(fields, name)
+	}
+
+	loader.mu.Lock()
+	defer loader.mu.Unlock()
+	if loader.fields == nil {
+		loader.fields = make(map[string][]string)
+	}
+	loader.fields[locale] = fields
+}
+
+// key returns the hash of a locale.
+func (loader *RedisLoader) key(locale string) string {
+	if loader.KeyPrefix == "" {
+		return "i18n:" + locale
+	}
+	return loader.KeyPrefix + locale
+}
+
+// channel returns the invalidation channel.
+func (loader *RedisLoader) channel() string {
+	if loader.Channel == "" {
+		return "i18n:invalidate"
+	}
+	return loader.Channel
+}
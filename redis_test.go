@@ -0,0 +1,114 @@
+package i18n
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testRedisClient is an in-memory `RedisClient`.
+type testRedisClient struct {
+	mu       sync.Mutex
+	hashes   map[string]map[string]string
+	channels map[string]chan string
+}
+
+func (c *testRedisClient) HGetAll(_ context.Context, key string) (map[string]string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	hash := make(map[string]string)
+	for field, value := range c.hashes[key] {
+		hash[field] = value
+	}
+	return hash, nil
+}
+
+func (c *testRedisClient) Subscribe(_ context.Context, channel string) (<-chan string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ch := make(chan string, 1)
+	c.channels[channel] = ch
+	return ch, nil
+}
+
+func (c *testRedisClient) hset(key, field, value string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.hashes[key][field] = value
+}
+
+func (c *testRedisClient) hdel(key, field string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.hashes[key], field)
+}
+
+func (c *testRedisClient) publish(channel, message string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ch, ok := c.channels[channel]
+	if ok {
+		ch <- message
+	}
+	return ok
+}
+
+func TestRedisLoader(t *testing.T) {
+	assert := assert.New(t)
+
+	client := &testRedisClient{
+		hashes: map[string]map[string]string{
+			"i18n:en":      {"hello": "Hello"},
+			"i18n:zh-Hans": {"hello": "你好"},
+		},
+		channels: make(map[string]chan string),
+	}
+	loader := &RedisLoader{Client: client, Locales: []string{"en", "zh-Hans"}}
+
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "zh-Hans"),
+	)
+	assert.NoError(bundle.LoadFrom(context.Background(), loader))
+	assert.Equal("你好", bundle.NewLocalizer("zh-Hans").Get("hello"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- loader.Subscribe(ctx, bundle) }()
+
+	client.hset("i18n:zh-Hans", "hello", "您好")
+	assert.Eventually(func() bool {
+		return client.publish("i18n:invalidate", "zh-Hans")
+	}, 5*time.Second, time.Millisecond)
+	assert.Eventually(func() bool {
+		return bundle.NewLocalizer("zh-Hans").Get("hello") == "您好"
+	}, 5*time.Second, time.Millisecond)
+
+	client.hset("i18n:en", "bye", "Bye")
+	client.publish("i18n:invalidate", "")
+	assert.Eventually(func() bool {
+		return bundle.NewLocalizer("en").Get("bye") == "Bye"
+	}, 5*time.Second, time.Millisecond)
+
+	// The deleted fields are removed from the bundle.
+	client.hdel("i18n:en", "hello")
+	client.publish("i18n:invalidate", "en")
+	assert.Eventually(func() bool {
+		_, err := bundle.NewLocalizer("en").GetE("hello")
+		return errors.Is(err, ErrMissingMessage)
+	}, 5*time.Second, time.Millisecond)
+	assert.Equal("Bye", bundle.NewLocalizer("en").Get("bye"))
+	assert.Equal("您好", bundle.NewLocalizer("zh-Hans").Get("hello"))
+
+	cancel()
+	assert.NoError(<-done)
+}
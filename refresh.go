@@ -0,0 +1,123 @@
+package i18n
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+)
+
+// RefreshEvent describes a refresh of the remote translation files, see `WithRefreshInterval`.
+type RefreshEvent struct {
+	// Changed are the URLs whose translations changed and were applied.
+	Changed []string
+	// Err is the error of the files that failed, their translations are unchanged.
+	Err error
+}
+
+// WithRefreshInterval fetches the files loaded by `LoadHTTP` again on a schedule. The translations of a changed
+// file are applied atomically like `AddMessages` does, the unchanged files (a `304` or the same messages) are
+// skipped. Call `Close` to stop refreshing.
+func WithRefreshInterval(interval time.Duration) func(*I18n) {
+	return func(bundle *I18n) {
+		bundle.refreshInterval = interval
+	}
+}
+
+// WithRefreshHook registers a hook that is called after every refresh, see `WithRefreshInterval`.
+// The hook must be safe for concurrent use.
+func WithRefreshHook(hook func(RefreshEvent)) func(*I18n) {
+	return func(bundle *I18n) {
+		bundle.refreshHooks = append(bundle.refreshHooks, hook)
+	}
+}
+
+// refresher refreshes the remote translation files of a bundle.
+type refresher struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// startRefresh starts refreshing the remote files if the refresh is enabled and not started yet.
+func (bundle *I18n) startRefresh() {
+	if bundle.refreshInterval <= 0 {
+		return
+	}
+
+	bundle.mu.Lock()
+	defer bundle.mu.Unlock()
+
+	if bundle.refresher != nil {
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	r := &refresher{
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+	bundle.refresher = r
+	go r.run(ctx, bundle)
+}
+
+// run refreshes the files until the context is canceled.
+func (r *refresher) run(ctx context.Context, bundle *I18n) {
+	defer close(r.done)
+
+	ticker := time.NewTicker(bundle.refreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			event := bundle.refresh(ctx)
+			if ctx.Err() != nil {
+				return
+			}
+			for _, hook := range bundle.refreshHooks {
+				hook(event)
+			}
+		}
+	}
+}
+
+// close
+func (r *refresher) close() {
+	r.cancel()
+	<-r.done
+}
+
+// refresh fetches the remote files again and applies the changed ones.
+func (bundle *I18n) refresh(ctx context.Context) RefreshEvent {
+	bundle.remoteMu.Lock()
+	urls := make([]string, 0, len(bundle.remotes))
+	for u := range bundle.remotes {
+		urls = append(urls, u)
+	}
+	bundle.remoteMu.Unlock()
+	slices.Sort(urls)
+
+	var (
+		event RefreshEvent
+		errs  []error
+	)
+	for _, u := range urls {
+		source, changed, err := bundle.fetch(ctx, u)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if !changed {
+			continue
+		}
+		err = bundle.AddMessages(source.locale, source.messages)
+		if err != nil && !errors.Is(err, ErrInvalidLocale) {
+			errs = append(errs, err)
+			continue
+		}
+		bundle.cacheRemote(u, source)
+		event.Changed = append(event.Changed, u)
+	}
+	event.Err = errors.Join(errs...)
+	return event
+}
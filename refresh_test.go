@@ -0,0 +1,55 @@
+package i18n
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithRefreshInterval(t *testing.T) {
+	assert := assert.New(t)
+
+	var content atomic.Value
+	content.Store(`{"hello": "Hello"}`)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(content.Load().(string)))
+	}))
+	defer server.Close()
+
+	events := make(chan RefreshEvent, 100)
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithHTTPClient(server.Client()),
+		WithRefreshInterval(10*time.Millisecond),
+		WithRefreshHook(func(event RefreshEvent) { events <- event }),
+	)
+	t.Cleanup(func() { assert.NoError(bundle.Close()) })
+	assert.NoError(bundle.LoadHTTP(context.Background(), server.URL+"/en.json"))
+	assert.Equal("Hello", bundle.NewLocalizer("en").Get("hello"))
+
+	// The unchanged content isn't applied.
+	event := <-events
+	assert.Empty(event.Changed)
+	assert.NoError(event.Err)
+
+	content.Store(`{"hello": "Hi"}`)
+	assert.Eventually(func() bool {
+		event := <-events
+		return len(event.Changed) == 1 && event.Changed[0] == server.URL+"/en.json"
+	}, 5*time.Second, time.Millisecond)
+	assert.Equal("Hi", bundle.NewLocalizer("en").Get("hello"))
+
+	content.Store(`{"hello": "{name"}`)
+	assert.Eventually(func() bool {
+		return (<-events).Err != nil
+	}, 5*time.Second, time.Millisecond)
+	assert.Equal("Hi", bundle.NewLocalizer("en").Get("hello"))
+
+	assert.NoError(bundle.Close())
+	assert.Nil(bundle.refresher)
+}
@@ -0,0 +1,87 @@
+package i18n
+
+import (
+	"errors"
+	"io/fs"
+	"sync"
+)
+
+// pack is the translations of a library, see `Register`.
+type pack struct {
+	namespace string
+	fsys      fs.FS
+	patterns  []string
+}
+
+var (
+	packsMu sync.Mutex
+	packs   []pack
+)
+
+// Register registers the translations of a library under a namespace, usually in its `init` function, so the
+// bundles created afterwards absorb them: the files of the `fs.FS` matching the patterns (all the files at
+// its root if none) are loaded like `LoadFS` does, and the names are prefixed like `mylib.hello`. The application
+// loads its own translations of the same names afterwards to override them. It panics if the namespace
+// is registered twice, like `sql.Register`.
+//
+//	//go:embed locales/*.json
+//	var locales embed.FS
+//
+//	func init() {
+//		i18n.Register("mylib", locales, "locales/*.json")
+//	}
+func Register(namespace string, fsys fs.FS, patterns ...string) {
+	packsMu.Lock()
+	defer packsMu.Unlock()
+
+	if fsys == nil {
+		panic("i18n: Register fs is nil")
+	}
+	for _, p := range packs {
+		if p.namespace == namespace {
+			panic("i18n: Register called twice for namespace " + namespace)
+		}
+	}
+	if len(patterns) == 0 {
+		patterns = []string{"*"}
+	}
+	packs = append(packs, pack{namespace: namespace, fsys: fsys, patterns: patterns})
+}
+
+// WithRegisteredTranslations controls whether the bundle absorbs the translations of the libraries
+// registered by `Register` (enabled by default).
+func WithRegisteredTranslations(enabled bool) func(*I18n) {
+	return func(bundle *I18n) {
+		bundle.ignorePacks = !enabled
+	}
+}
+
+// loadPacks loads the translations of the registered libraries, the locales that fail are logged to
+// the `WithLogger` logger and skipped, the unsupported locales are ignored.
+func (bundle *I18n) loadPacks() {
+	if bundle.ignorePacks {
+		return
+	}
+
+	packsMu.Lock()
+	registered := append([]pack(nil), packs...)
+	packsMu.Unlock()
+
+	for _, p := range registered {
+		data, err := readFS(p.fsys, p.patterns, nil)
+		if err != nil {
+			bundle.logLoadError(p.namespace, err)
+			continue
+		}
+		for locale, trans := range data {
+			namespaced := make(map[string]string, len(trans))
+			for name, text := range trans {
+				namespaced[p.namespace+"."+name] = text
+			}
+			err := bundle.AddMessages(locale, namespaced)
+			if err != nil && !errors.Is(err, ErrInvalidLocale) {
+				bundle.logLoadError(p.namespace, err)
+			}
+		}
+	}
+}
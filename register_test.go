@@ -0,0 +1,46 @@
+package i18n
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegister(t *testing.T) {
+	assert := assert.New(t)
+
+	Register("registertest", fstest.MapFS{
+		"en.json":   {Data: []byte(`{"hello": "Hello", "bye": "Bye"}`)},
+		"fr.json":   {Data: []byte(`{"hello": "Bonjour"}`)},
+		"es.json":   {Data: []byte(`{"hello": "{"}`)},
+		"docs/x.md": {Data: []byte(`# Docs`)},
+	})
+	assert.Panics(func() {
+		Register("registertest", fstest.MapFS{})
+	})
+
+	var logs bytes.Buffer
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "es"),
+		WithLogger(slog.New(slog.NewTextHandler(&logs, nil))),
+	)
+	assert.NoError(bundle.LoadMessages(map[string]map[string]string{
+		"en": {"registertest.bye": "Goodbye"},
+	}))
+	assert.Contains(logs.String(), "registertest")
+
+	localizer := bundle.NewLocalizer("en")
+	assert.Equal("Hello", localizer.Get("registertest.hello"))
+	assert.Equal("Goodbye", localizer.Get("registertest.bye"))
+
+	bundle = NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en"),
+		WithRegisteredTranslations(false),
+	)
+	assert.Equal("registertest.hello", bundle.NewLocalizer("en").Get("registertest.hello"))
+}
@@ -0,0 +1,167 @@
+package i18n
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// ErrHTTPStatus is returned when a remote translation file cannot be fetched.
+var ErrHTTPStatus = errors.New("i18n: unexpected http status")
+
+// defaultHTTPClient fetches the remote translation files if `WithHTTPClient` isn't set.
+var defaultHTTPClient = &http.Client{Timeout: 30 * time.Second}
+
+// WithHTTPClient sets the client of `LoadHTTP`, its timeout applies to each attempt.
+func WithHTTPClient(client *http.Client) func(*I18n) {
+	return func(bundle *I18n) {
+		bundle.httpClient = client
+	}
+}
+
+// WithHTTPRetries sets how many times `LoadHTTP` retries a request that failed on a network error
+// or a 429/5xx response, with an exponential backoff (2 by default).
+func WithHTTPRetries(retries int) func(*I18n) {
+	return func(bundle *I18n) {
+		bundle.httpRetries = retries
+	}
+}
+
+// remoteSource is a remote translation file, cached for the conditional requests.
+type remoteSource struct {
+	locale       string
+	etag         string
+	lastModified string
+	messages     map[string]string
+}
+
+// LoadHTTP fetches the translation files from the URLs (e.g. a CDN or a translation service), the files are named
+// after their locale like `LoadFiles` does (`https://cdn.example.com/locales/zh-Hans.json`). The responses are
+// cached: the next loads send `If-None-Match` and `If-Modified-Since`, and the unchanged files aren't parsed again.
+func (bundle *I18n) LoadHTTP(ctx context.Context, urls ...string) error {
+	data := make(map[string]map[string]string)
+	sources := make(map[string]remoteSource)
+	for _, u := range urls {
+		source, changed, err := bundle.fetch(ctx, u)
+		if err != nil {
+			return err
+		}
+		if !changed {
+			continue
+		}
+		sources[u] = source
+		if _, ok := data[source.locale]; !ok {
+			data[source.locale] = make(map[string]string)
+		}
+		for name, text := range source.messages {
+			data[source.locale][name] = text
+		}
+	}
+	if len(data) > 0 {
+		if err := bundle.LoadMessages(data); err != nil {
+			return err
+		}
+	}
+	for u, source := range sources {
+		bundle.cacheRemote(u, source)
+	}
+	bundle.startRefresh()
+	return nil
+}
+
+// fetch fetches a remote translation file, changed is false if the cached one is still valid.
+func (bundle *I18n) fetch(ctx context.Context, rawURL string) (source remoteSource, changed bool, err error) {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return source, false, err
+	}
+
+	bundle.remoteMu.Lock()
+	cached, ok := bundle.remotes[rawURL]
+	bundle.remoteMu.Unlock()
+
+	var resp *http.Response
+	for attempt := 0; ; attempt++ {
+		resp, err = bundle.request(ctx, rawURL, cached, ok)
+		retryable := err != nil || resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+		if !retryable || attempt >= bundle.httpRetries || ctx.Err() != nil {
+			break
+		}
+		if resp != nil {
+			resp.Body.Close()
+		}
+		select {
+		case <-ctx.Done():
+			return source, false, ctx.Err()
+		case <-time.After(100 * time.Millisecond << attempt):
+		}
+	}
+	if err != nil {
+		return source, false, err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotModified && ok:
+		return cached, false, nil
+	case resp.StatusCode != http.StatusOK:
+		return source, false, fmt.Errorf("%w: %s of %q", ErrHTTPStatus, resp.Status, rawURL)
+	}
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return source, false, err
+	}
+	messages, err := bundle.unmarshalMessages(parsed.Path, b)
+	if err != nil {
+		return source, false, fmt.Errorf("%s: %w", rawURL, err)
+	}
+	source = remoteSource{
+		locale:       nameInsenstive(parsed.Path),
+		etag:         resp.Header.Get("ETag"),
+		lastModified: resp.Header.Get("Last-Modified"),
+		messages:     messages,
+	}
+	if ok && maps.Equal(cached.messages, messages) {
+		// The server doesn't support the conditional requests.
+		bundle.cacheRemote(rawURL, source)
+		return source, false, nil
+	}
+	return source, true, nil
+}
+
+// cacheRemote caches a remote translation file once its translations are loaded.
+func (bundle *I18n) cacheRemote(rawURL string, source remoteSource) {
+	bundle.remoteMu.Lock()
+	defer bundle.remoteMu.Unlock()
+
+	if bundle.remotes == nil {
+		bundle.remotes = make(map[string]remoteSource)
+	}
+	bundle.remotes[rawURL] = source
+}
+
+// request sends a request, conditional if the file is cached.
+func (bundle *I18n) request(ctx context.Context, rawURL string, cached remoteSource, conditional bool) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	if conditional {
+		if cached.etag != "" {
+			req.Header.Set("If-None-Match", cached.etag)
+		}
+		if cached.lastModified != "" {
+			req.Header.Set("If-Modified-Since", cached.lastModified)
+		}
+	}
+	client := bundle.httpClient
+	if client == nil {
+		client = defaultHTTPClient
+	}
+	return client.Do(req)
+}
@@ -0,0 +1,74 @@
+package i18n
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadHTTP(t *testing.T) {
+	assert := assert.New(t)
+
+	var (
+		content     atomic.Value
+		requests    atomic.Int32
+		failures    atomic.Int32
+		notModified atomic.Int32
+	)
+	content.Store(`{"hello": "Hello"}`)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if failures.Load() > 0 {
+			failures.Add(-1)
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		switch r.URL.Path {
+		case "/locales/en.json":
+			etag := `"` + content.Load().(string) + `"`
+			if r.Header.Get("If-None-Match") == etag {
+				notModified.Add(1)
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+			w.Header().Set("ETag", etag)
+			_, _ = w.Write([]byte(content.Load().(string)))
+		case "/locales/zh-Hans.yaml":
+			_, _ = w.Write([]byte("hello: 你好\n"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "zh-Hans"),
+		WithHTTPClient(server.Client()),
+		WithHTTPRetries(1),
+	)
+	ctx := context.Background()
+	assert.NoError(bundle.LoadHTTP(ctx, server.URL+"/locales/en.json", server.URL+"/locales/zh-Hans.yaml"))
+	assert.Equal("Hello", bundle.NewLocalizer("en").Get("hello"))
+	assert.Equal("你好", bundle.NewLocalizer("zh-Hans").Get("hello"))
+
+	// The unchanged file isn't sent again.
+	assert.NoError(bundle.LoadHTTP(ctx, server.URL+"/locales/en.json"))
+	assert.Equal(int32(1), notModified.Load())
+
+	content.Store(`{"hello": "Hi"}`)
+	failures.Store(1)
+	requests.Store(0)
+	assert.NoError(bundle.LoadHTTP(ctx, server.URL+"/locales/en.json"))
+	assert.Equal(int32(2), requests.Load())
+	assert.Equal("Hi", bundle.NewLocalizer("en").Get("hello"))
+
+	failures.Store(2)
+	assert.ErrorIs(bundle.LoadHTTP(ctx, server.URL+"/locales/en.json"), ErrHTTPStatus)
+	assert.ErrorIs(bundle.LoadHTTP(ctx, server.URL+"/locales/fr.json"), ErrHTTPStatus)
+	assert.Equal("Hi", bundle.NewLocalizer("en").Get("hello"))
+}
@@ -0,0 +1,105 @@
+package i18n
+
+import (
+	"container/list"
+	"sync"
+)
+
+// WithRuntimeCacheSize limits the number of names parsed at runtime that are cached (1000 by default),
+// see `WithRuntimeParsing`. The least recently used names are evicted first, so the arbitrary names
+// (e.g. the user-supplied ones) cannot grow the memory without bound. A size of 0 disables the cache,
+// the names are parsed on every lookup.
+func WithRuntimeCacheSize(size int) func(*I18n) {
+	return func(bundle *I18n) {
+		bundle.runtimeCacheSize = max(size, 0)
+	}
+}
+
+// runtimeCache is a least recently used cache of the translations parsed at runtime, it has its own lock
+// so the lookups don't take the lock of the bundle to write it.
+type runtimeCache struct {
+	mu    sync.Mutex
+	size  int
+	items map[string]*list.Element
+	order *list.List
+}
+
+// runtimeEntry
+type runtimeEntry struct {
+	name  string
+	trans *parsedTranslation
+}
+
+// newRuntimeCache
+func newRuntimeCache(size int) *runtimeCache {
+	return &runtimeCache{
+		size:  size,
+		items: make(map[string]*list.Element),
+		order: list.New(),
+	}
+}
+
+// get returns the translation of the name and marks it as recently used.
+func (c *runtimeCache) get(name string) (*parsedTranslation, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.items[name]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(elem)
+	return elem.Value.(*runtimeEntry).trans, true
+}
+
+// peek returns the translation of the name without the lock nor marking it, for the caches that are never written.
+func (c *runtimeCache) peek(name string) (*parsedTranslation, bool) {
+	elem, ok := c.items[name]
+	if !ok {
+		return nil, false
+	}
+	return elem.Value.(*runtimeEntry).trans, true
+}
+
+// add caches the translation of the name, and evicts the least recently used names over the size.
+func (c *runtimeCache) add(name string, trans *parsedTranslation) {
+	if c.size == 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.items[name]; ok {
+		elem.Value.(*runtimeEntry).trans = trans
+		c.order.MoveToFront(elem)
+		return
+	}
+	c.items[name] = c.order.PushFront(&runtimeEntry{name: name, trans: trans})
+	for c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.items, oldest.Value.(*runtimeEntry).name)
+	}
+}
+
+// len
+func (c *runtimeCache) len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.order.Len()
+}
+
+// copy returns a copy of the cache with the same names in the same order.
+func (c *runtimeCache) copy() *runtimeCache {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	copied := newRuntimeCache(c.size)
+	for elem := c.order.Back(); elem != nil; elem = elem.Prev() {
+		entry := elem.Value.(*runtimeEntry)
+		copied.items[entry.name] = copied.order.PushFront(&runtimeEntry{name: entry.name, trans: entry.trans})
+	}
+	return copied
+}
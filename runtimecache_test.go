@@ -0,0 +1,53 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRuntimeCacheSize(t *testing.T) {
+	assert := assert.New(t)
+
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithRuntimeCacheSize(2),
+	)
+	localizer := bundle.NewLocalizer("en")
+	assert.Equal("Hello {name}", localizer.Get("Hello {name}"))
+	assert.Equal("Bye", localizer.Get("Bye"))
+	assert.Equal("Hello Yami", localizer.Get("Hello {name}", Vars{"name": "Yami"}))
+	assert.Equal("Welcome", localizer.Get("Welcome"))
+
+	// The least recently used name is evicted.
+	cache := bundle.runtimeParsedTranslations
+	assert.Equal(2, cache.len())
+	assert.Contains(cache.items, "Hello {name}")
+	assert.Contains(cache.items, "Welcome")
+	assert.NotContains(cache.items, "Bye")
+
+	bundle = NewBundle(
+		WithDefaultLocale("en"),
+		WithRuntimeCacheSize(0),
+	)
+	assert.Equal("Hello Yami", bundle.NewLocalizer("en").Get("Hello {name}", Vars{"name": "Yami"}))
+	assert.Zero(bundle.runtimeParsedTranslations.len())
+}
+
+func TestRuntimeCacheCopy(t *testing.T) {
+	assert := assert.New(t)
+
+	cache := newRuntimeCache(2)
+	cache.add("a", &parsedTranslation{text: "a"})
+	cache.add("b", &parsedTranslation{text: "b"})
+	cache.get("a")
+
+	copied := cache.copy()
+	copied.add("c", &parsedTranslation{text: "c"})
+	assert.Equal(2, cache.len())
+	_, ok := copied.get("b")
+	assert.False(ok)
+	trans, ok := copied.peek("a")
+	assert.True(ok)
+	assert.Equal("a", trans.text)
+}
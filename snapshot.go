@@ -0,0 +1,106 @@
+package i18n
+
+import "golang.org/x/text/language"
+
+// catalog is the state of a bundle that `Swap` replaces atomically.
+type catalog struct {
+	defaultLocale      string
+	defaultLanguage    language.Tag
+	languages          []language.Tag
+	languageMatcher    language.Matcher
+	fallbacks          map[string][]string
+	parsedTranslations map[string]map[string]*parsedTranslation
+}
+
+// Snapshot returns an independent copy of the bundle with the same options and catalogs. The copy can be
+// modified in the background, e.g. reloaded from scratch, then switched in with `Swap`. The copy doesn't watch
+// the files nor refresh the remote files, see `WithWatch` and `WithRefreshInterval`.
+func (bundle *I18n) Snapshot() *I18n {
+	bundle.mu.RLock()
+	defer bundle.mu.RUnlock()
+
+	snapshot := &I18n{
+		unmarshaler:               bundle.unmarshaler,
+		runtimeParsedTranslations: newRuntimeCache(bundle.runtimeCacheSize),
+		runtimeCacheSize:          bundle.runtimeCacheSize,
+		compileConcurrency:        bundle.compileConcurrency,
+		lazyCompile:               bundle.lazyCompile,
+		clientPrefixes:            bundle.clientPrefixes,
+		serverOnlyPrefixes:        bundle.serverOnlyPrefixes,
+		lookupHooks:               bundle.lookupHooks,
+		fallbackHooks:             bundle.fallbackHooks,
+		compileErrorHooks:         bundle.compileErrorHooks,
+		mustHandler:               bundle.mustHandler,
+		pluralRules:               bundle.pluralRules,
+		matcherFunc:               bundle.matcherFunc,
+		preferredLocales:          bundle.preferredLocales,
+		debug:                     bundle.debug,
+		missingHandlers:           bundle.missingHandlers,
+		missingMarker:             bundle.missingMarker,
+		hideMissingKeys:           bundle.hideMissingKeys,
+		bidiIsolation:             bundle.bidiIsolation,
+		parentFallbacks:           bundle.parentFallbacks,
+		logger:                    bundle.logger,
+		missingLimiter:            bundle.missingLimiter,
+		disableRuntimeParsing:     bundle.disableRuntimeParsing,
+		httpClient:                bundle.httpClient,
+		httpRetries:               bundle.httpRetries,
+	}
+	snapshot.setCatalog(bundle.copyCatalog())
+	return snapshot
+}
+
+// Swap replaces the languages, the fallbacks and the catalogs of the bundle with a copy of the ones of
+// the other bundle, atomically: the localizers see either the old or the new translations, never a mix.
+// It fails with `ErrFrozen` if the bundle is frozen, see `Freeze`.
+func (bundle *I18n) Swap(other *I18n) error {
+	if bundle.frozen {
+		return ErrFrozen
+	}
+	other.mu.RLock()
+	c := other.copyCatalog()
+	other.mu.RUnlock()
+
+	bundle.mu.Lock()
+	defer bundle.mu.Unlock()
+
+	bundle.setCatalog(c)
+	// The runtime translations are parsed for the default locale, which can change.
+	bundle.runtimeParsedTranslations = newRuntimeCache(bundle.runtimeCacheSize)
+	return nil
+}
+
+// copyCatalog, the caller must hold the lock.
+func (bundle *I18n) copyCatalog() catalog {
+	c := catalog{
+		defaultLocale:      bundle.defaultLocale,
+		defaultLanguage:    bundle.defaultLanguage,
+		languages:          append([]language.Tag(nil), bundle.languages...),
+		languageMatcher:    bundle.languageMatcher,
+		fallbacks:          make(map[string][]string, len(bundle.fallbacks)),
+		parsedTranslations: make(map[string]map[string]*parsedTranslation, len(bundle.parsedTranslations)),
+	}
+	for locale, fallbacks := range bundle.fallbacks {
+		c.fallbacks[locale] = append([]string(nil), fallbacks...)
+	}
+	for locale, translations := range bundle.parsedTranslations {
+		// The translations themselves are immutable once parsed, they can be shared.
+		copied := make(map[string]*parsedTranslation, len(translations))
+		for name, trans := range translations {
+			copied[name] = trans
+		}
+		c.parsedTranslations[locale] = copied
+	}
+	return c
+}
+
+// setCatalog, the caller must hold the lock.
+func (bundle *I18n) setCatalog(c catalog) {
+	bundle.defaultLocale = c.defaultLocale
+	bundle.defaultLanguage = c.defaultLanguage
+	bundle.languages = c.languages
+	bundle.languageMatcher = c.languageMatcher
+	bundle.fallbacks = c.fallbacks
+	bundle.parsedTranslations = c.parsedTranslations
+	bundle.resolvedLocalizers = nil
+}
@@ -0,0 +1,64 @@
+package i18n
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnapshotSwap(t *testing.T) {
+	assert := assert.New(t)
+
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "zh-Hans"),
+	)
+	assert.NoError(bundle.LoadMessages(map[string]map[string]string{
+		"en":      {"hello": "Hello", "bye": "Bye"},
+		"zh-Hans": {"hello": "你好"},
+	}))
+	localizer := bundle.NewLocalizer("zh-Hans")
+
+	snapshot := bundle.Snapshot()
+	assert.NoError(snapshot.RegisterLocale("ja"))
+	assert.NoError(snapshot.LoadMessages(map[string]map[string]string{
+		"en":      {"bye": "Goodbye"},
+		"zh-Hans": {"bye": "再见"},
+		"ja":      {"hello": "こんにちは"},
+	}))
+	assert.Equal("Bye", localizer.Get("bye"))
+	assert.Equal("再见", snapshot.NewLocalizer("zh-Hans").Get("bye"))
+	assert.Len(bundle.SupportedLanguages(), 2)
+
+	assert.NoError(bundle.Swap(snapshot))
+	assert.Equal("再见", localizer.Get("bye"))
+	assert.Equal("こんにちは", bundle.NewLocalizer("ja").Get("hello"))
+	assert.Len(bundle.SupportedLanguages(), 3)
+
+	// The bundles stay independent after the swap.
+	assert.NoError(snapshot.AddMessages("zh-Hans", map[string]string{"bye": "拜拜"}))
+	assert.Equal("再见", localizer.Get("bye"))
+}
+
+func TestSwapConcurrency(t *testing.T) {
+	bundle := NewBundle(WithDefaultLocale("en"))
+	assert.NoError(t, bundle.LoadMessages(map[string]map[string]string{"en": {"hello": "Hello"}}))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			snapshot := bundle.Snapshot()
+			_ = snapshot.AddMessages("en", map[string]string{"hello": "Hi"})
+			assert.NoError(t, bundle.Swap(snapshot))
+		}()
+		go func() {
+			defer wg.Done()
+			text := bundle.NewLocalizer("en").Get("hello")
+			assert.Contains(t, []string{"Hello", "Hi"}, text)
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,441 @@
+package i18n
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/number"
+)
+
+// spellOutRule spells out the magnitude of the cardinal numbers, the sign is prefixed with `minus`.
+type spellOutRule struct {
+	minus string
+	spell func(n uint64) string
+}
+
+// spellOutRules are keyed by the locales whose words differ from their CLDR parents.
+var spellOutRules = map[string]spellOutRule{
+	"en":      {"minus ", spellOutEnglish},
+	"de":      {"minus ", spellOutGerman},
+	"es":      {"menos ", spellOutSpanish},
+	"fr":      {"moins ", spellOutFrench},
+	"ja":      {"マイナス", spellOutJapanese},
+	"zh":      {"负", spellOutChinese([5]string{"", "万", "亿", "万亿", "亿亿"})},
+	"zh-Hant": {"負", spellOutChinese([5]string{"", "萬", "億", "兆", "京"})},
+}
+
+// SpellOut spells out an integer in words with the cardinal rules of the locale,
+// e.g. `forty-two` in `en` and `四十二` in `zh` for 42, for the amounts of legal and financial texts.
+// The rules are the ones of `en`, `de`, `es`, `fr`, `ja`, `zh` (simplified) and `zh-Hant` (traditional), with their
+// regional variants. The numbers with a fraction, and the numbers of the other locales like `ru` and `ko`,
+// are formatted with digits like `FormatNumber`, e.g. `1 234` in `ru`.
+func (localizer *Localizer) SpellOut(v any) string {
+	tag := localizer.tag()
+	s, ok := spellOut(tag, v)
+	if !ok {
+		return formatNumber(tag, v, nil, number.Decimal)
+	}
+	return s
+}
+
+// spellOut spells out an integer with the rule of the locale, false if it has no rule or the number has a fraction.
+func spellOut(tag language.Tag, v any) (string, bool) {
+	n, ok := float64Value(v)
+	if !ok || n != math.Trunc(n) || math.Abs(n) >= math.MaxUint64 {
+		return "", false
+	}
+	rule, ok := lookupSpellOutRule(tag)
+	if !ok {
+		return "", false
+	}
+	// The integers are converted without the float64 to keep the precision of large int64 and uint64.
+	var magnitude uint64
+	switch v := v.(type) {
+	case int:
+		magnitude = absInt64(int64(v))
+	case int8:
+		magnitude = absInt64(int64(v))
+	case int16:
+		magnitude = absInt64(int64(v))
+	case int32:
+		magnitude = absInt64(int64(v))
+	case int64:
+		magnitude = absInt64(v)
+	case uint:
+		magnitude = uint64(v)
+	case uint8:
+		magnitude = uint64(v)
+	case uint16:
+		magnitude = uint64(v)
+	case uint32:
+		magnitude = uint64(v)
+	case uint64:
+		magnitude = v
+	default:
+		magnitude = uint64(math.Abs(n))
+	}
+	if n < 0 {
+		return rule.minus + rule.spell(magnitude), true
+	}
+	return rule.spell(magnitude), true
+}
+
+// lookupSpellOutRule returns the rule of the nearest locale in the CLDR parent chain of the tag.
+func lookupSpellOutRule(tag language.Tag) (spellOutRule, bool) {
+	for t := tag; !t.IsRoot(); t = t.Parent() {
+		if rule, ok := spellOutRules[t.String()]; ok {
+			return rule, true
+		}
+	}
+	base, _ := tag.Base()
+	rule, ok := spellOutRules[base.String()]
+	return rule, ok
+}
+
+// absInt64 returns the magnitude of an int64, including the one of math.MinInt64.
+func absInt64(n int64) uint64 {
+	if n < 0 {
+		return uint64(-(n + 1)) + 1
+	}
+	return uint64(n)
+}
+
+// splitThousands splits a number in groups of 3 digits, from the lowest.
+func splitThousands(n uint64) []uint64 {
+	var groups []uint64
+	for ; n > 0; n /= 1000 {
+		groups = append(groups, n%1000)
+	}
+	return groups
+}
+
+var (
+	englishOnes   = [...]string{"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine", "ten", "eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen", "seventeen", "eighteen", "nineteen"}
+	englishTens   = [...]string{"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety"}
+	englishScales = [...]string{"", "thousand", "million", "billion", "trillion", "quadrillion", "quintillion"}
+)
+
+// spellOutEnglish spells out a number like `one thousand two hundred thirty-four`.
+func spellOutEnglish(n uint64) string {
+	if n == 0 {
+		return englishOnes[0]
+	}
+	groups := splitThousands(n)
+	var words []string
+	for i := len(groups) - 1; i >= 0; i-- {
+		g := groups[i]
+		if g == 0 {
+			continue
+		}
+		if g >= 100 {
+			words = append(words, englishOnes[g/100], "hundred")
+		}
+		switch g %= 100; {
+		case g >= 20 && g%10 != 0:
+			words = append(words, englishTens[g/10]+"-"+englishOnes[g%10])
+		case g >= 20:
+			words = append(words, englishTens[g/10])
+		case g > 0:
+			words = append(words, englishOnes[g])
+		}
+		if i > 0 {
+			words = append(words, englishScales[i])
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+var (
+	germanOnes   = [...]string{"null", "eins", "zwei", "drei", "vier", "fünf", "sechs", "sieben", "acht", "neun", "zehn", "elf", "zwölf", "dreizehn", "vierzehn", "fünfzehn", "sechzehn", "siebzehn", "achtzehn", "neunzehn"}
+	germanTens   = [...]string{"", "", "zwanzig", "dreißig", "vierzig", "fünfzig", "sechzig", "siebzig", "achtzig", "neunzig"}
+	germanScales = [...][2]string{{}, {}, {"Million", "Millionen"}, {"Milliarde", "Milliarden"}, {"Billion", "Billionen"}, {"Billiarde", "Billiarden"}, {"Trillion", "Trillionen"}}
+)
+
+// spellOutGerman spells out a number like `eintausendzweihundertvierunddreißig`,
+// with the millions and above as separate nouns like `zwei Millionen`.
+func spellOutGerman(n uint64) string {
+	if n == 0 {
+		return germanOnes[0]
+	}
+	groups := splitThousands(n)
+	var words []string
+	// The groups below a million are written as a single word.
+	var below string
+	for i := len(groups) - 1; i >= 0; i-- {
+		g := groups[i]
+		switch {
+		case g == 0:
+		case i >= 2 && g == 1:
+			words = append(words, "eine "+germanScales[i][0])
+		case i >= 2:
+			words = append(words, germanHundreds(g, false)+" "+germanScales[i][1])
+		case i == 1:
+			below += germanHundreds(g, false) + "tausend"
+		default:
+			below += germanHundreds(g, true)
+		}
+	}
+	if below != "" {
+		words = append(words, below)
+	}
+	return strings.Join(words, " ")
+}
+
+// germanHundreds spells out a number below 1000, with `ein` instead of `eins` if it's not final.
+func germanHundreds(n uint64, final bool) string {
+	var s string
+	if n >= 100 {
+		s = germanUnit(n/100, false) + "hundert"
+	}
+	switch n %= 100; {
+	case n >= 20 && n%10 != 0:
+		s += germanUnit(n%10, false) + "und" + germanTens[n/10]
+	case n >= 20:
+		s += germanTens[n/10]
+	case n > 0:
+		s += germanUnit(n, final)
+	}
+	return s
+}
+
+// germanUnit returns the word of a number below 20, `ein` for 1 if it's not final.
+func germanUnit(n uint64, final bool) string {
+	if n == 1 && !final {
+		return "ein"
+	}
+	return germanOnes[n]
+}
+
+var (
+	spanishOnes     = [...]string{"cero", "uno", "dos", "tres", "cuatro", "cinco", "seis", "siete", "ocho", "nueve", "diez", "once", "doce", "trece", "catorce", "quince", "dieciséis", "diecisiete", "dieciocho", "diecinueve", "veinte", "veintiuno", "veintidós", "veintitrés", "veinticuatro", "veinticinco", "veintiséis", "veintisiete", "veintiocho", "veintinueve"}
+	spanishTens     = [...]string{"", "", "", "treinta", "cuarenta", "cincuenta", "sesenta", "setenta", "ochenta", "noventa"}
+	spanishHundreds = [...]string{"", "ciento", "doscientos", "trescientos", "cuatrocientos", "quinientos", "seiscientos", "setecientos", "ochocientos", "novecientos"}
+	spanishScales   = [...][2]string{{}, {"millón", "millones"}, {"billón", "billones"}, {"trillón", "trillones"}}
+)
+
+// spellOutSpanish spells out a number like `mil doscientos treinta y cuatro`,
+// with the long scale of Spanish where a `billón` is a million millions.
+func spellOutSpanish(n uint64) string {
+	if n == 0 {
+		return spanishOnes[0]
+	}
+	// The groups of the long scale have 6 digits, the thousands of a group are written with `mil`.
+	var groups []uint64
+	for ; n > 0; n /= 1000000 {
+		groups = append(groups, n%1000000)
+	}
+	var words []string
+	for i := len(groups) - 1; i >= 0; i-- {
+		g := groups[i]
+		switch {
+		case g == 0:
+			continue
+		case i > 0 && g == 1:
+			words = append(words, "un", spanishScales[i][0])
+			continue
+		}
+		if thousands := g / 1000; thousands == 1 {
+			words = append(words, "mil")
+		} else if thousands > 1 {
+			words = append(words, spanishHundreds999(thousands, false), "mil")
+		}
+		if g%1000 > 0 {
+			words = append(words, spanishHundreds999(g%1000, i == 0))
+		}
+		if i > 0 {
+			words = append(words, spanishScales[i][1])
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// spanishHundreds999 spells out a number below 1000, with the apocope of `uno` to `un` if it's not final.
+func spanishHundreds999(n uint64, final bool) string {
+	if n == 100 {
+		return "cien"
+	}
+	var words []string
+	if n >= 100 {
+		words = append(words, spanishHundreds[n/100])
+	}
+	var s string
+	switch n %= 100; {
+	case n >= 30 && n%10 != 0:
+		s = spanishTens[n/10] + " y " + spanishOnes[n%10]
+	case n >= 30:
+		s = spanishTens[n/10]
+	case n > 0:
+		s = spanishOnes[n]
+	}
+	if !final && n%10 == 1 && n != 11 {
+		if n == 21 {
+			s = "veintiún"
+		} else {
+			s = strings.TrimSuffix(s, "uno") + "un"
+		}
+	}
+	if s != "" {
+		words = append(words, s)
+	}
+	return strings.Join(words, " ")
+}
+
+var (
+	frenchOnes   = [...]string{"zéro", "un", "deux", "trois", "quatre", "cinq", "six", "sept", "huit", "neuf", "dix", "onze", "douze", "treize", "quatorze", "quinze", "seize", "dix-sept", "dix-huit", "dix-neuf"}
+	frenchTens   = [...]string{"", "", "vingt", "trente", "quarante", "cinquante", "soixante", "soixante", "quatre-vingt", "quatre-vingt"}
+	frenchScales = [...][2]string{{}, {}, {"million", "millions"}, {"milliard", "milliards"}, {"billion", "billions"}, {"billiard", "billiards"}, {"trillion", "trillions"}}
+)
+
+// spellOutFrench spells out a number like `mille deux cent trente-quatre`, with `vingt-et-un` like ICU.
+func spellOutFrench(n uint64) string {
+	if n == 0 {
+		return frenchOnes[0]
+	}
+	groups := splitThousands(n)
+	var words []string
+	for i := len(groups) - 1; i >= 0; i-- {
+		g := groups[i]
+		switch {
+		case g == 0:
+		case i == 1 && g == 1:
+			words = append(words, "mille")
+		case i == 1:
+			// `cents` and `vingts` are invariable before `mille`.
+			words = append(words, frenchHundreds(g, false), "mille")
+		case i >= 2 && g == 1:
+			words = append(words, "un", frenchScales[i][0])
+		case i >= 2:
+			words = append(words, frenchHundreds(g, true), frenchScales[i][1])
+		default:
+			words = append(words, frenchHundreds(g, true))
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// frenchHundreds spells out a number below 1000, with the plural of `cents` and `quatre-vingts` if they end it.
+func frenchHundreds(n uint64, plural bool) string {
+	var words []string
+	if h := n / 100; h > 0 {
+		s := "cent"
+		if h > 1 {
+			s = frenchOnes[h] + " cent"
+			if plural && n%100 == 0 {
+				s += "s"
+			}
+		}
+		words = append(words, s)
+	}
+	if n %= 100; n > 0 {
+		words = append(words, frenchTens99(n, plural))
+	}
+	return strings.Join(words, " ")
+}
+
+// frenchTens99 spells out a number below 100, with the vigesimal tens of 70 and 90.
+func frenchTens99(n uint64, plural bool) string {
+	if n < 20 {
+		return frenchOnes[n]
+	}
+	tens, units := n/10, n%10
+	if tens == 7 || tens == 9 {
+		units += 10
+	}
+	switch {
+	case units == 0 && tens == 8 && plural:
+		return "quatre-vingts"
+	case units == 0:
+		return frenchTens[tens]
+	case (units == 1 || units == 11) && tens != 8 && tens != 9:
+		return frenchTens[tens] + "-et-" + frenchOnes[units]
+	}
+	return frenchTens[tens] + "-" + frenchOnes[units]
+}
+
+var (
+	japaneseDigits = [...]string{"〇", "一", "二", "三", "四", "五", "六", "七", "八", "九"}
+	japaneseUnits  = [...]string{"", "万", "億", "兆", "京"}
+)
+
+// spellOutJapanese spells out a number like `一万二千三百四十五`, without `一` before `十`, `百` and `千`.
+func spellOutJapanese(n uint64) string {
+	if n == 0 {
+		return japaneseDigits[0]
+	}
+	var s string
+	for i := len(japaneseUnits) - 1; i >= 0; i-- {
+		g := n / uint64(math.Pow10(4*i)) % 10000
+		if g == 0 {
+			continue
+		}
+		for j, name := range [...]string{"千", "百", "十", ""} {
+			d := g / uint64(math.Pow10(3-j)) % 10
+			switch {
+			case d == 0:
+			case d == 1 && name != "":
+				s += name
+			default:
+				s += japaneseDigits[d] + name
+			}
+		}
+		s += japaneseUnits[i]
+	}
+	return s
+}
+
+var chineseDigits = [...]string{"零", "一", "二", "三", "四", "五", "六", "七", "八", "九"}
+
+// spellOutChinese returns the rules spelling out a number like `一万零一十` with the units of 10^4 of a script,
+// with `零` for the gaps and without `一` before a leading `十`.
+func spellOutChinese(units [5]string) func(n uint64) string {
+	return func(n uint64) string {
+		if n == 0 {
+			return chineseDigits[0]
+		}
+		var s string
+		// zero is set when a gap of digits was skipped after the first nonzero digit.
+		zero := false
+		for i := len(units) - 1; i >= 0; i-- {
+			g := n / uint64(math.Pow10(4*i)) % 10000
+			if g == 0 {
+				zero = s != ""
+				continue
+			}
+			for j, name := range [...]string{"千", "百", "十", ""} {
+				d := g / uint64(math.Pow10(3-j)) % 10
+				if d == 0 {
+					zero = s != ""
+					continue
+				}
+				if zero {
+					s += chineseDigits[0]
+					zero = false
+				}
+				if d == 1 && name == "十" && s == "" {
+					s += name
+				} else {
+					s += chineseDigits[d] + name
+				}
+			}
+			// The trailing zeros of a group are not a gap, the unit of the group follows them.
+			s += units[i]
+			zero = false
+		}
+		return s
+	}
+}
+
+// formatSpellOutArgument formats the value of a `{n, spellout}` argument like `SpellOut`: in words in the locales
+// with rules (`en`, `de`, `es`, `fr`, `ja`, `zh` and `zh-Hant`), with digits in the other ones.
+func formatSpellOutArgument(tag language.Tag, value any, _ string) (string, error) {
+	value, ok := numberArgument(value)
+	if !ok {
+		return "", fmt.Errorf("invalid number: %T", value)
+	}
+	if s, ok := spellOut(tag, value); ok {
+		return s, nil
+	}
+	return formatNumber(tag, value, nil, number.Decimal), nil
+}
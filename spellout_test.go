@@ -0,0 +1,135 @@
+package i18n
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpellOut(t *testing.T) {
+	assert := assert.New(t)
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "de", "es", "fr", "ja", "zh-Hans", "zh-Hant", "ko"),
+	)
+	messages := make(map[string]map[string]string)
+	for _, tag := range bundle.SupportedLanguages() {
+		messages[tag.String()] = map[string]string{"hello": "hello"}
+	}
+	assert.NoError(bundle.LoadMessages(messages))
+
+	cases := []struct {
+		locale string
+		value  any
+		want   string
+	}{
+		{"en", 0, "zero"},
+		{"en", 42, "forty-two"},
+		{"en", 1234, "one thousand two hundred thirty-four"},
+		{"en", 2000015, "two million fifteen"},
+		{"en", -7, "minus seven"},
+		{"en", int64(math.MinInt64), "minus nine quintillion two hundred twenty-three quadrillion three hundred seventy-two trillion thirty-six billion eight hundred fifty-four million seven hundred seventy-five thousand eight hundred eight"},
+		{"en", 3.0, "three"},
+		{"en", 2.5, "2.5"},
+		{"de", 1, "eins"},
+		{"de", 21, "einundzwanzig"},
+		{"de", 101, "einhunderteins"},
+		{"de", 1234, "eintausendzweihundertvierunddreißig"},
+		{"de", 2001000, "zwei Millionen eintausend"},
+		{"de", 1000000, "eine Million"},
+		{"es", 21, "veintiuno"},
+		{"es", 100, "cien"},
+		{"es", 1234, "mil doscientos treinta y cuatro"},
+		{"es", 21000, "veintiún mil"},
+		{"es", 31000000, "treinta y un millones"},
+		{"es", 1000000000, "mil millones"},
+		{"fr", 21, "vingt-et-un"},
+		{"fr", 71, "soixante-et-onze"},
+		{"fr", 80, "quatre-vingts"},
+		{"fr", 91, "quatre-vingt-onze"},
+		{"fr", 200, "deux cents"},
+		{"fr", 80200, "quatre-vingt mille deux cents"},
+		{"fr", 1234, "mille deux cent trente-quatre"},
+		{"fr", 2000000, "deux millions"},
+		{"ja", 0, "〇"},
+		{"ja", 42, "四十二"},
+		{"ja", 12345, "一万二千三百四十五"},
+		{"zh-Hans", 10, "十"},
+		{"zh-Hans", 42, "四十二"},
+		{"zh-Hans", 110, "一百一十"},
+		{"zh-Hans", 1001, "一千零一"},
+		{"zh-Hans", 10010, "一万零一十"},
+		{"zh-Hans", 100000001, "一亿零一"},
+		{"zh-Hans", -5, "负五"},
+		{"zh-Hant", 120000, "十二萬"},
+		{"ja", -5, "マイナス五"},
+		{"ko", 42, "42"},
+	}
+	for _, c := range cases {
+		assert.Equal(c.want, bundle.NewLocalizer(c.locale).SpellOut(c.value), "%s %v", c.locale, c.value)
+	}
+}
+
+func TestSpellOutArguments(t *testing.T) {
+	assert := assert.New(t)
+
+	bundle := NewBundle(WithDefaultLocale("en"), WithLocales("en", "zh-Hans"))
+	assert.NoError(bundle.LoadMessages(map[string]map[string]string{
+		"en":      {"amount": "{n, spellout} dollars ({n, number})"},
+		"zh-Hans": {"amount": "{n, spellout}元"},
+	}))
+
+	assert.Equal("forty-two dollars (42)", bundle.NewLocalizer("en").Get("amount", Vars{"n": 42}))
+	assert.Equal("四十二元", bundle.NewLocalizer("zh-Hans").Get("amount", Vars{"n": 42}))
+}
+
+func TestSpellOutUnsupportedLocale(t *testing.T) {
+	assert := assert.New(t)
+
+	bundle := NewBundle(WithDefaultLocale("en"), WithLocales("en", "ru", "ko"))
+	assert.NoError(bundle.LoadMessages(map[string]map[string]string{
+		"ru": {"amount": "{n, spellout} руб."},
+		"ko": {"amount": "{n, spellout}원"},
+	}))
+
+	// The locales without rules get the number with the digits and the separators of the locale.
+	assert.Equal("1\u00a0234", bundle.NewLocalizer("ru").SpellOut(1234))
+	assert.Equal("1,234", bundle.NewLocalizer("ko").SpellOut(1234))
+	assert.Equal("42 руб.", bundle.NewLocalizer("ru").Get("amount", Vars{"n": 42}))
+}
+
+func TestSpellOutArgumentTypes(t *testing.T) {
+	assert := assert.New(t)
+
+	bundle := NewBundle(WithDefaultLocale("en"), WithLocales("en"))
+	assert.NoError(bundle.LoadMessages(map[string]map[string]string{
+		"en": {"amount": "{n, spellout}"},
+	}))
+	localizer := bundle.NewLocalizer("en")
+
+	cases := []struct {
+		value any
+		want  string
+	}{
+		{int(42), "forty-two"},
+		{int8(-42), "minus forty-two"},
+		{int16(42), "forty-two"},
+		{int32(42), "forty-two"},
+		{int64(42), "forty-two"},
+		{uint(42), "forty-two"},
+		{uint8(42), "forty-two"},
+		{uint16(42), "forty-two"},
+		{uint32(42), "forty-two"},
+		{uint64(9007199254740993), "nine quadrillion seven trillion one hundred ninety-nine billion two hundred fifty-four million seven hundred forty thousand nine hundred ninety-three"},
+		{float32(42), "forty-two"},
+		{float64(42), "forty-two"},
+		{float32(2.5), "2.5"},
+		{"42", "forty-two"},
+	}
+	for _, c := range cases {
+		s, err := localizer.GetE("amount", Vars{"n": c.value})
+		assert.NoError(err, "%T", c.value)
+		assert.Equal(c.want, s, "%T", c.value)
+	}
+}
@@ -0,0 +1,104 @@
+package i18n
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// ErrInvalidSQLIdentifier is returned when a table or a column of a `SQLLoader` isn't a plain identifier.
+var ErrInvalidSQLIdentifier = errors.New("i18n: invalid sql identifier")
+
+// Loader loads the translations from a source that isn't a file, like a database, see `LoadFrom`.
+type Loader interface {
+	// Load returns the translations of the locales, like `LoadMessages` takes them.
+	Load(ctx context.Context) (map[string]map[string]string, error)
+}
+
+// LoadFrom loads the translations from the loaders, the later loaders override the same names like `ChainLoader`.
+func (bundle *I18n) LoadFrom(ctx context.Context, loaders ...Loader) error {
+	data, err := ChainLoader(loaders).Load(ctx)
+	if err != nil {
+		return err
+	}
+	return bundle.LoadMessages(data)
+}
+
+// SQLLoader is a `Loader` that reads the translations from a database table, one row per locale and name.
+// The translations maintained in an admin UI can feed the bundle.
+type SQLLoader struct {
+	DB *sql.DB
+	// Table is the name of the table, `translations` by default.
+	Table string
+	// LocaleColumn, NameColumn and TextColumn are the columns of the table, `locale`, `name` and `text` by default.
+	LocaleColumn string
+	NameColumn   string
+	TextColumn   string
+	// Where filters the rows (e.g. `published = ?`) with the Args, optional. It's written as is in the query,
+	// it must not come from the users.
+	Where string
+	Args  []any
+}
+
+// sqlIdentifier matches the plain identifiers like `i18n.translations`.
+var sqlIdentifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)?$`)
+
+// Load reads the translations of the table.
+func (loader *SQLLoader) Load(ctx context.Context) (map[string]map[string]string, error) {
+	query, err := loader.query()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := loader.DB.QueryContext(ctx, query, loader.Args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	data := make(map[string]map[string]string)
+	for rows.Next() {
+		var locale, name string
+		var text sql.NullString
+		if err := rows.Scan(&locale, &name, &text); err != nil {
+			return nil, err
+		}
+		if !text.Valid {
+			// The untranslated rows are skipped, so the fallbacks apply.
+			continue
+		}
+		if _, ok := data[locale]; !ok {
+			data[locale] = make(map[string]string)
+		}
+		data[locale][name] = text.String
+	}
+	return data, rows.Err()
+}
+
+// query returns the query of the translations.
+func (loader *SQLLoader) query() (string, error) {
+	or := func(v, def string) string {
+		if v == "" {
+			return def
+		}
+		return v
+	}
+	identifiers := []string{
+		or(loader.LocaleColumn, "locale"),
+		or(loader.NameColumn, "name"),
+		or(loader.TextColumn, "text"),
+		or(loader.Table, "translations"),
+	}
+	for _, identifier := range identifiers {
+		if !sqlIdentifier.MatchString(identifier) {
+			return "", fmt.Errorf("%w: %q", ErrInvalidSQLIdentifier, identifier)
+		}
+	}
+	query := fmt.Sprintf("SELECT %s FROM %s", strings.Join(identifiers[:3], ", "), identifiers[3])
+	if loader.Where != "" {
+		query += " WHERE " + loader.Where
+	}
+	return query, nil
+}
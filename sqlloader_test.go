@@ -0,0 +1,104 @@
+package i18n
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testSQLDriver is a database driver that returns the same rows for every query, and records the queries.
+type testSQLDriver struct {
+	rows    [][]driver.Value
+	queries []string
+	args    [][]driver.Value
+}
+
+func (d *testSQLDriver) Open(string) (driver.Conn, error) { return testSQLConn{d}, nil }
+
+type testSQLConn struct{ driver *testSQLDriver }
+
+func (c testSQLConn) Prepare(query string) (driver.Stmt, error) {
+	return testSQLStmt{c.driver, query}, nil
+}
+func (c testSQLConn) Close() error              { return nil }
+func (c testSQLConn) Begin() (driver.Tx, error) { return nil, errors.New("unsupported") }
+
+type testSQLStmt struct {
+	driver *testSQLDriver
+	query  string
+}
+
+func (s testSQLStmt) Close() error  { return nil }
+func (s testSQLStmt) NumInput() int { return -1 }
+func (s testSQLStmt) Exec([]driver.Value) (driver.Result, error) {
+	return nil, errors.New("unsupported")
+}
+
+func (s testSQLStmt) Query(args []driver.Value) (driver.Rows, error) {
+	s.driver.queries = append(s.driver.queries, s.query)
+	s.driver.args = append(s.driver.args, args)
+	return &testSQLRows{rows: s.driver.rows}, nil
+}
+
+type testSQLRows struct{ rows [][]driver.Value }
+
+func (r *testSQLRows) Columns() []string { return []string{"locale", "name", "text"} }
+func (r *testSQLRows) Close() error      { return nil }
+func (r *testSQLRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+	return nil
+}
+
+func TestSQLLoader(t *testing.T) {
+	assert := assert.New(t)
+
+	d := &testSQLDriver{rows: [][]driver.Value{
+		{"en", "hello", "Hello, {name}"},
+		{"zh-Hans", "hello", "你好, {name}"},
+		{"zh-Hans", "bye", nil},
+		{"en", "bye", "Bye"},
+	}}
+	sql.Register("i18n-test", d)
+	db, err := sql.Open("i18n-test", "")
+	assert.NoError(err)
+	defer db.Close()
+
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "zh-Hans"),
+	)
+	loader := &SQLLoader{DB: db}
+	assert.NoError(bundle.LoadFrom(context.Background(), loader))
+	assert.Equal("SELECT locale, name, text FROM translations", d.queries[0])
+	localizer := bundle.NewLocalizer("zh-Hans")
+	assert.Equal("你好, Yami", localizer.Get("hello", Vars{"name": "Yami"}))
+	assert.Equal("Bye", localizer.Get("bye"))
+
+	loader = &SQLLoader{
+		DB:           db,
+		Table:        "i18n.messages",
+		LocaleColumn: "lang",
+		NameColumn:   "msg_id",
+		TextColumn:   "msg_text",
+		Where:        "published = ?",
+		Args:         []any{true},
+	}
+	data, err := loader.Load(context.Background())
+	assert.NoError(err)
+	assert.Equal("Bye", data["en"]["bye"])
+	assert.Equal("SELECT lang, msg_id, msg_text FROM i18n.messages WHERE published = ?", d.queries[1])
+	assert.Equal([]driver.Value{true}, d.args[1])
+
+	loader.Table = "messages; DROP TABLE users"
+	_, err = loader.Load(context.Background())
+	assert.ErrorIs(err, ErrInvalidSQLIdentifier)
+}
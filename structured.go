@@ -0,0 +1,127 @@
+package i18n
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/goccy/go-json"
+)
+
+// GetAny returns a translated string like `Get`, or the structured value of the messages nested in the name,
+// like `errors.auth` for `errors.auth.invalid` and `errors.auth.locked`: the objects are `map[string]any`,
+// the arrays (indexed like `steps.0`) are `[]any` and the messages are translated strings, each falling back
+// on its own. It returns nil if no message is found.
+func (localizer *Localizer) GetAny(name string, data ...Vars) any {
+	if localizer.Has(name) {
+		return localizer.Get(name, data...)
+	}
+
+	bundle := localizer.bundle
+	prefix := name + "."
+	nested := make(map[string]*parsedTranslation)
+	if !bundle.frozen {
+		bundle.mu.RLock()
+	}
+	for key := range bundle.parsedTranslations[localizer.locale] {
+		if path, ok := strings.CutPrefix(key, prefix); ok {
+			nested[path], _ = localizer.lookupCatalog(key)
+		}
+	}
+	if !bundle.frozen {
+		bundle.mu.RUnlock()
+	}
+
+	localizer.observe(name, len(nested) > 0, data...)
+	if len(nested) == 0 {
+		return nil
+	}
+	tree := make(map[string]any)
+	for path, trans := range nested {
+		insertNested(tree, strings.Split(path, "."), localizer.localize(trans, data...))
+	}
+	return structuredValue(tree)
+}
+
+// GetSlice returns the translated strings of an array of messages, indexed like `steps.0`, `steps.1`.
+// Each item falls back on its own, so a locale translating the first items only gets the rest from its fallbacks.
+// It returns nil if the array is not found.
+func (localizer *Localizer) GetSlice(name string, data ...Vars) []string {
+	bundle := localizer.bundle
+	var items []*parsedTranslation
+	if !bundle.frozen {
+		bundle.mu.RLock()
+	}
+	for i := 0; ; i++ {
+		trans, found := localizer.lookupCatalog(name + "." + strconv.Itoa(i))
+		if !found {
+			break
+		}
+		items = append(items, trans)
+	}
+	if !bundle.frozen {
+		bundle.mu.RUnlock()
+	}
+
+	localizer.observe(name, len(items) > 0, data...)
+	if len(items) == 0 {
+		return nil
+	}
+	texts := make([]string, len(items))
+	for i, trans := range items {
+		texts[i] = localizer.localize(trans, data...)
+	}
+	return texts
+}
+
+// GetAs unmarshals the structured value of a message into T, see `GetAny`, like a `[]string` of steps
+// or a struct of the `title` and `body` nested messages. It returns `ErrMissingMessage` if no message is found.
+func GetAs[T any](localizer *Localizer, name string, data ...Vars) (T, error) {
+	var v T
+	value := localizer.GetAny(name, data...)
+	if value == nil {
+		return v, fmt.Errorf("%w: %q", ErrMissingMessage, name)
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		return v, err
+	}
+	err = json.Unmarshal(b, &v)
+	return v, err
+}
+
+// insertNested sets the text at the path of the tree, the objects win over the messages of the same path.
+func insertNested(tree map[string]any, path []string, text string) {
+	for _, key := range path[:len(path)-1] {
+		child, ok := tree[key].(map[string]any)
+		if !ok {
+			child = make(map[string]any)
+			tree[key] = child
+		}
+		tree = child
+	}
+	if _, ok := tree[path[len(path)-1]]; !ok {
+		tree[path[len(path)-1]] = text
+	}
+}
+
+// structuredValue converts the objects of the tree indexed from 0 without gaps to arrays.
+func structuredValue(v any) any {
+	tree, ok := v.(map[string]any)
+	if !ok {
+		return v
+	}
+	for key, child := range tree {
+		tree[key] = structuredValue(child)
+	}
+
+	list := make([]any, len(tree))
+	for key, child := range tree {
+		i, err := strconv.Atoi(key)
+		if err != nil || i < 0 || i >= len(list) || strconv.Itoa(i) != key {
+			return tree
+		}
+		list[i] = child
+	}
+	return list
+}
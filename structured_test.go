@@ -0,0 +1,65 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetAny(t *testing.T) {
+	assert := assert.New(t)
+
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "zh-Hans"),
+	)
+	assert.NoError(bundle.LoadFiles("test/nested/en.json", "test/nested/zh-Hans.yml"))
+	localizer := bundle.NewLocalizer("zh-Hans")
+
+	assert.Equal("你好", localizer.GetAny("hello"))
+	assert.Equal([]any{"注册", "验证邮箱"}, localizer.GetAny("steps"))
+	assert.Equal(map[string]any{
+		"invalid": "凭证无效",
+		"locked":  "Account locked for 5 minutes",
+	}, localizer.GetAny("errors.auth", Vars{"minutes": 5}))
+	assert.Nil(localizer.GetAny("steps.2"))
+
+	steps, err := GetAs[[]string](localizer, "steps")
+	assert.NoError(err)
+	assert.Equal([]string{"注册", "验证邮箱"}, steps)
+
+	type authErrors struct {
+		Invalid string `json:"invalid"`
+		Locked  string `json:"locked"`
+	}
+	auth, err := GetAs[authErrors](bundle.NewLocalizer("en"), "errors.auth", Vars{"minutes": 5})
+	assert.NoError(err)
+	assert.Equal(authErrors{Invalid: "Invalid credentials", Locked: "Account locked for 5 minutes"}, auth)
+
+	_, err = GetAs[[]string](localizer, "tips")
+	assert.ErrorIs(err, ErrMissingMessage)
+}
+
+func TestGetSlice(t *testing.T) {
+	assert := assert.New(t)
+
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "zh-Hans"),
+	)
+	assert.NoError(bundle.LoadMessages(map[string]map[string]string{
+		"en": {
+			"tips.0": "Press {key} to search",
+			"tips.1": "Drag to reorder",
+			"tips.2": "Double-click to edit",
+		},
+		"zh-Hans": {
+			"tips.0": "按 {key} 搜索",
+			"tips.1": "拖动以排序",
+		},
+	}))
+
+	assert.Equal([]string{"按 / 搜索", "拖动以排序", "Double-click to edit"}, bundle.NewLocalizer("zh-Hans").GetSlice("tips", Vars{"key": "/"}))
+	assert.Equal([]string{"Press {key} to search", "Drag to reorder", "Double-click to edit"}, bundle.NewLocalizer("en").GetSlice("tips"))
+	assert.Nil(bundle.NewLocalizer("en").GetSlice("steps"))
+}
@@ -0,0 +1,42 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTenantOverrides(t *testing.T) {
+	assert := assert.New(t)
+
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "zh-Hans"),
+	)
+	assert.NoError(bundle.LoadMessages(map[string]map[string]string{
+		"en":      {"project": "Project", "hello": "Hello, {name}", "bye": "Bye"},
+		"zh-Hans": {"project": "项目"},
+	}))
+	assert.NoError(bundle.SetTenantOverrides("acme", map[string]map[string]string{
+		"en":      {"project": "Workspace", "bye": "See you"},
+		"zh_hans": {"project": "工作区"},
+	}))
+
+	localizer := bundle.NewLocalizer("zh-Hans")
+	acme := localizer.ForTenant("acme")
+	assert.Equal("工作区", acme.Get("project"))
+	assert.Equal("项目", localizer.Get("project"))
+	assert.Equal("项目", localizer.ForTenant("globex").Get("project"))
+
+	// The override of the locale a fallback comes from applies.
+	assert.Equal("See you", acme.Get("bye"))
+	assert.Equal("Hello, Yami", acme.Get("hello", Vars{"name": "Yami"}))
+	assert.Equal("Workspace", bundle.NewLocalizer("en").ForTenant("acme").Get("project"))
+
+	assert.ErrorIs(bundle.SetTenantOverrides("acme", map[string]map[string]string{"fr": {"project": "Projet"}}), ErrInvalidLocale)
+	assert.Error(bundle.SetTenantOverrides("acme", map[string]map[string]string{"en": {"project": "{name"}}))
+	assert.Equal("Workspace", bundle.NewLocalizer("en").ForTenant("acme").Get("project"))
+
+	assert.NoError(bundle.RemoveTenantOverrides("acme"))
+	assert.Equal("项目", acme.Get("project"))
+}
@@ -0,0 +1,36 @@
+### French translations.
+
+-brand = Firefox
+    .gender = masculine
+
+## Messages
+
+hello = Bonjour, { $name } !
+about = À propos de { -brand }
+brand-update = { -brand.gender ->
+    [masculine] Le { -brand } est à jour.
+   *[other] La { -brand } est à jour.
+}
+
+# Selectors on numbers are plural arguments.
+emails =
+    { $count ->
+        [0] Aucun e-mail
+        [one] { $count } e-mail
+       *[other] { NUMBER($count) } e-mails
+    }
+
+role = { $role ->
+    [admin] Administrateur
+   *[user] Utilisateur
+}
+
+login = Connexion
+    .placeholder = Adresse e-mail
+    .title = Se connecter à { about }
+
+multiline =
+    Première ligne
+    Deuxième ligne avec {"{"}accolades{"}"} et #
+
+welcome = Bienvenue, { login }
@@ -0,0 +1,105 @@
+package i18n
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/number"
+)
+
+// UnitWidth is the width of the unit names, e.g. `hours`, `hr` and `h`.
+type UnitWidth string
+
+// The unit widths of CLDR.
+const (
+	UnitLong   UnitWidth = "long"
+	UnitShort  UnitWidth = "short"
+	UnitNarrow UnitWidth = "narrow"
+)
+
+// index returns the index of the width in the patterns of `localeData.units`, the one of `UnitShort` if unknown.
+func (width UnitWidth) index() int {
+	switch width {
+	case UnitLong:
+		return 0
+	case UnitNarrow:
+		return 2
+	}
+	return 1
+}
+
+// unitConversion converts a measure to the unit of the other measurement system.
+type unitConversion struct {
+	unit    string
+	convert func(v float64) float64
+}
+
+// metricUnits and imperialUnits convert the units of a measurement system to the ones of the other.
+var (
+	metricUnits = map[string]unitConversion{
+		"kilometer": {"mile", func(v float64) float64 { return v / 1.609344 }},
+		"meter":     {"foot", func(v float64) float64 { return v / 0.3048 }},
+		"kilogram":  {"pound", func(v float64) float64 { return v / 0.45359237 }},
+		"celsius":   {"fahrenheit", func(v float64) float64 { return v*9/5 + 32 }},
+	}
+	imperialUnits = map[string]unitConversion{
+		"mile":       {"kilometer", func(v float64) float64 { return v * 1.609344 }},
+		"foot":       {"meter", func(v float64) float64 { return v * 0.3048 }},
+		"pound":      {"kilogram", func(v float64) float64 { return v * 0.45359237 }},
+		"fahrenheit": {"celsius", func(v float64) float64 { return (v - 32) * 5 / 9 }},
+	}
+)
+
+// imperialRegions are the regions that measure in miles, pounds and degrees Fahrenheit.
+var imperialRegions = map[string]bool{"US": true, "LR": true, "MM": true}
+
+// FormatUnit formats a measure with the CLDR name of the unit in the locale, e.g. `5.2 kilometers` in `en`
+// and `5,2 километра` in `ru` for `UnitLong`. The units are `kilometer`, `meter`, `mile`, `foot`, `kilogram`,
+// `pound`, `celsius` and `fahrenheit`, and the ones of `FormatDuration` like `hour`.
+func (localizer *Localizer) FormatUnit(v any, unit string, width UnitWidth) string {
+	tag := localizer.tag()
+	return localizer.formatUnit(tag, lookupLocaleData(tag), unit, width, v)
+}
+
+// FormatPreferredUnit formats a measure like `FormatUnit`, converted to the measurement system of the region
+// of the locale, e.g. `3.107 mi` in `en-US` and `5 km` in `en-GB` for 5 kilometers.
+func (localizer *Localizer) FormatPreferredUnit(v any, unit string, width UnitWidth) string {
+	tag := localizer.tag()
+	region, _ := tag.Region()
+	conversions := metricUnits
+	if !imperialRegions[region.String()] {
+		conversions = imperialUnits
+	}
+	if conversion, ok := conversions[unit]; ok {
+		if n, ok := float64Value(v); ok {
+			v, unit = conversion.convert(n), conversion.unit
+		}
+	}
+	return localizer.formatUnit(tag, lookupLocaleData(tag), unit, width, v)
+}
+
+// formatUnit formats a number with the pattern of a unit in the locale, selected by the plural category of the number.
+// The patterns of `en` are used if the locale has none.
+func (localizer *Localizer) formatUnit(tag language.Tag, data *localeData, unit string, width UnitWidth, v any) string {
+	patterns, ok := data.units[unit]
+	if !ok {
+		patterns, ok = localeDataTable["en"].units[unit]
+	}
+	s := formatNumber(tag, v, nil, number.Decimal)
+	if !ok {
+		return s + " " + unit
+	}
+	pattern := patterns[width.index()]["other"]
+	if n, ok := float64Value(v); ok {
+		// The category is the one of the number as displayed, rounded to 3 fraction digits.
+		operand := strconv.FormatFloat(math.Abs(math.Round(n*1000)/1000), 'f', -1, 64)
+		if category, err := localizer.bundle.pluralCategory(localizer.locale, operand, false); err == nil {
+			if p, found := patterns[width.index()][category]; found {
+				pattern = p
+			}
+		}
+	}
+	return strings.Replace(pattern, "{0}", s, 1)
+}
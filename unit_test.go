@@ -0,0 +1,51 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatUnit(t *testing.T) {
+	assert := assert.New(t)
+	bundle := newTestParseBundle()
+
+	cases := []struct {
+		locale string
+		value  any
+		unit   string
+		width  UnitWidth
+		want   string
+	}{
+		{"en", 5.2, "kilometer", UnitLong, "5.2 kilometers"},
+		{"en", 1, "kilometer", UnitLong, "1 kilometer"},
+		{"en", 5.2, "kilometer", UnitShort, "5.2 km"},
+		{"en", 5.2, "kilometer", UnitNarrow, "5.2km"},
+		{"en", 1, "foot", UnitLong, "1 foot"},
+		{"en", 3, "foot", UnitLong, "3 feet"},
+		{"en", 21.5, "celsius", UnitShort, "21.5°C"},
+		{"de", 5.2, "kilometer", UnitShort, "5,2 km"},
+		{"fr", 1.5, "kilogram", UnitLong, "1,5 kilogramme"},
+		{"ru", 5, "kilometer", UnitLong, "5 километров"},
+		{"ru", 5.2, "kilometer", UnitLong, "5,2 километра"},
+		{"ja", 20, "celsius", UnitLong, "摂氏 20 度"},
+		{"zh-Hans", 5, "kilometer", UnitLong, "5公里"},
+		{"ar", 5, "kilometer", UnitShort, "٥ km"},
+		{"en", 2, "hour", UnitLong, "2 hours"},
+		{"en", 7, "parsec", UnitLong, "7 parsec"},
+	}
+	for _, c := range cases {
+		assert.Equal(c.want, bundle.NewLocalizer(c.locale).FormatUnit(c.value, c.unit, c.width), "%s %v %s", c.locale, c.value, c.unit)
+	}
+}
+
+func TestFormatPreferredUnit(t *testing.T) {
+	assert := assert.New(t)
+	bundle := newTestParseBundle()
+
+	assert.Equal("3.107 mi", bundle.NewLocalizer("en").FormatPreferredUnit(5, "kilometer", UnitShort))
+	assert.Equal("5 km", bundle.NewLocalizer("en-GB").FormatPreferredUnit(5, "kilometer", UnitShort))
+	assert.Equal("68°F", bundle.NewLocalizer("en").FormatPreferredUnit(20, "celsius", UnitShort))
+	assert.Equal("20 °C", bundle.NewLocalizer("de").FormatPreferredUnit(68, "fahrenheit", UnitShort))
+	assert.Equal("1 kilogram", bundle.NewLocalizer("en-GB").FormatPreferredUnit(2.20462262, "pound", UnitLong))
+}
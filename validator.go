@@ -0,0 +1,73 @@
+package i18n
+
+import "fmt"
+
+// ValidatorPrefix prefixes the names of the messages of the validator tags, like `validator.required`.
+const ValidatorPrefix = "validator."
+
+// FieldError is the subset of the `FieldError` of github.com/go-playground/validator that is translated,
+// the package stays free of the validator dependency.
+type FieldError interface {
+	// Tag is the validation tag that failed, like `required` or `min`.
+	Tag() string
+	// Namespace is the path of the field, like `User.Email`.
+	Namespace() string
+	// Field is the name of the field.
+	Field() string
+	// Param is the param of the tag, like `3` of `min=3`.
+	Param() string
+	// Value is the actual value of the field.
+	Value() interface{}
+	Error() string
+}
+
+// ValidatorMessages are the English messages of the common validator tags, add them to the default locale
+// and translate them with the same names. The messages are given the `field`, `param` and `value` vars.
+var ValidatorMessages = map[string]string{
+	ValidatorPrefix + "default":  "{field} is invalid",
+	ValidatorPrefix + "required": "{field} is a required field",
+	ValidatorPrefix + "email":    "{field} must be a valid email address",
+	ValidatorPrefix + "url":      "{field} must be a valid URL",
+	ValidatorPrefix + "uuid":     "{field} must be a valid UUID",
+	ValidatorPrefix + "numeric":  "{field} must be a valid numeric value",
+	ValidatorPrefix + "alpha":    "{field} can only contain alphabetic characters",
+	ValidatorPrefix + "alphanum": "{field} can only contain alphanumeric characters",
+	ValidatorPrefix + "len":      "{field} must be {param} in length",
+	ValidatorPrefix + "min":      "{field} must be at least {param} in length",
+	ValidatorPrefix + "max":      "{field} must be at most {param} in length",
+	ValidatorPrefix + "gt":       "{field} must be greater than {param}",
+	ValidatorPrefix + "gte":      "{field} must be {param} or greater",
+	ValidatorPrefix + "lt":       "{field} must be less than {param}",
+	ValidatorPrefix + "lte":      "{field} must be {param} or less",
+	ValidatorPrefix + "oneof":    "{field} must be one of [{param}]",
+	ValidatorPrefix + "eqfield":  "{field} must be equal to {param}",
+}
+
+// TranslateValidationErrors translates the errors of a validation, like the `ValidationErrors` of
+// github.com/go-playground/validator, keyed by their namespaces. The message of an error is the one of its tag,
+// `validator.default` if missing, or the error itself. The names of the fields are translated with the `field`
+// context if they're in the catalogs, e.g. `Email <field>`.
+func TranslateValidationErrors[E FieldError](localizer *Localizer, errs []E) map[string]string {
+	messages := make(map[string]string, len(errs))
+	for _, fe := range errs {
+		field := fe.Field()
+		if name := withContext(field, "field"); localizer.Has(name) {
+			field = localizer.Get(name)
+		}
+		vars := Vars{
+			"field": field,
+			"param": fe.Param(),
+			"value": fmt.Sprint(fe.Value()),
+		}
+
+		message := fe.Error()
+		for _, name := range []string{ValidatorPrefix + fe.Tag(), ValidatorPrefix + "default"} {
+			if localizer.Has(name) {
+				message = localizer.Get(name, vars)
+				break
+			}
+		}
+		messages[fe.Namespace()] = message
+	}
+	return messages
+}
@@ -0,0 +1,57 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testFieldError mimics the `FieldError` of the validator, which has more methods than `FieldError`.
+type testFieldError interface {
+	FieldError
+	StructField() string
+}
+
+type testValidationErrors []testFieldError
+
+type testFieldErr struct {
+	tag, namespace, field, param string
+	value                        interface{}
+}
+
+func (e testFieldErr) Tag() string         { return e.tag }
+func (e testFieldErr) Namespace() string   { return e.namespace }
+func (e testFieldErr) Field() string       { return e.field }
+func (e testFieldErr) StructField() string { return e.field }
+func (e testFieldErr) Param() string       { return e.param }
+func (e testFieldErr) Value() interface{}  { return e.value }
+func (e testFieldErr) Error() string       { return e.namespace + " failed on " + e.tag }
+
+func TestTranslateValidationErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "zh-Hans"),
+	)
+	assert.NoError(bundle.AddMessages("en", ValidatorMessages))
+	assert.NoError(bundle.AddMessages("zh-Hans", map[string]string{
+		"validator.required": "{field}为必填字段",
+		"Email <field>":      "邮箱",
+	}))
+
+	errs := testValidationErrors{
+		testFieldErr{tag: "required", namespace: "User.Email", field: "Email"},
+		testFieldErr{tag: "min", namespace: "User.Name", field: "Name", param: "3", value: "Yo"},
+		testFieldErr{tag: "hexcolor", namespace: "User.Color", field: "Color", value: 42},
+	}
+	assert.Equal(map[string]string{
+		"User.Email": "邮箱为必填字段",
+		"User.Name":  "Name must be at least 3 in length",
+		"User.Color": "Color is invalid",
+	}, TranslateValidationErrors(bundle.NewLocalizer("zh-Hans"), errs))
+
+	assert.Equal(map[string]string{
+		"User.Color": "User.Color failed on hexcolor",
+	}, TranslateValidationErrors(NewBundle(WithDefaultLocale("en")).NewLocalizer("en"), errs[2:]))
+}
@@ -0,0 +1,225 @@
+package i18n
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/goccy/go-json"
+)
+
+// ErrInvalidVueI18n is returned when a Vue I18n file cannot be parsed.
+var ErrInvalidVueI18n = errors.New("i18n: invalid vue-i18n file")
+
+// UnmarshalVueI18n is an `Unmarshaler` for the Vue I18n JSON files, to be used with `WithUnmarshaler`.
+//
+// The messages are converted to ICU MessageFormat: the nested keys are joined with dots, the named (`{name}`)
+// and list (`{0}`) interpolations are kept as arguments, the literals (`{'@'}`) are escaped, and the linked
+// messages (`@:key`, `@:{'key'}`, `@.upper:key`) are inlined. The choices separated by `|` become a `plural`
+// argument on `count` like Vue I18n picks them, `{count}` and `{n}` become `#` in the choices.
+func UnmarshalVueI18n(data []byte, v any) error {
+	out, ok := v.(*map[string]string)
+	if !ok {
+		return fmt.Errorf("%w: unsupported target %T", ErrInvalidVueI18n, v)
+	}
+	if *out == nil {
+		*out = make(map[string]string)
+	}
+
+	var nested map[string]any
+	if err := json.Unmarshal(data, &nested); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidVueI18n, err)
+	}
+	r := &vueResource{messages: make(map[string]string)}
+	flattenMessages(r.messages, "", nested)
+	for name := range r.messages {
+		text, err := r.render(name, "", 0)
+		if err != nil {
+			return err
+		}
+		(*out)[name] = text
+	}
+	return nil
+}
+
+// vueResource is a parsed Vue I18n file.
+type vueResource struct {
+	messages map[string]string
+}
+
+// render converts a message to ICU MessageFormat, the modifier of a linked message changes the case of the text.
+func (r *vueResource) render(name, modifier string, depth int) (string, error) {
+	if depth > 16 {
+		// A link cycle.
+		return "", nil
+	}
+	choices := splitVueChoices(r.messages[name])
+	if len(choices) == 1 {
+		return r.renderText(name, choices[0], modifier, false, depth)
+	}
+
+	var b strings.Builder
+	b.WriteString("{count, plural,")
+	for i, choice := range choices {
+		text, err := r.renderText(name, strings.TrimSpace(choice), modifier, true, depth)
+		if err != nil {
+			return "", err
+		}
+		// Vue I18n picks the first of two choices for 1 and the second otherwise,
+		// and the n-th of more choices for n-1, the last one for the greater counts.
+		key := "=" + strconv.Itoa(i)
+		switch {
+		case i == len(choices)-1:
+			key = "other"
+		case len(choices) == 2:
+			key = "=1"
+		}
+		b.WriteString(" " + key + " {" + text + "}")
+	}
+	b.WriteString("}")
+	return b.String(), nil
+}
+
+// renderText converts the interpolations and the links of a text, `{count}` and `{n}` become `#` in a choice.
+func (r *vueResource) renderText(name, text, modifier string, choice bool, depth int) (string, error) {
+	var b strings.Builder
+	capitalize := modifier == "capitalize"
+	writeText := func(s string) {
+		switch {
+		case s == "":
+			return
+		case modifier == "upper":
+			s = strings.ToUpper(s)
+		case modifier == "lower":
+			s = strings.ToLower(s)
+		case capitalize:
+			first, size := utf8.DecodeRuneInString(s)
+			s = string(unicode.ToUpper(first)) + s[size:]
+			capitalize = false
+		}
+		b.WriteString(escapeICU(s))
+	}
+
+	for text != "" {
+		i := strings.IndexAny(text, "{@")
+		if i < 0 {
+			writeText(text)
+			break
+		}
+		writeText(text[:i])
+		text = text[i:]
+
+		if text[0] == '{' {
+			end := strings.IndexByte(text, '}')
+			if end < 0 {
+				return "", fmt.Errorf("%w: unclosed placeholder in %q", ErrInvalidVueI18n, name)
+			}
+			placeholder := strings.TrimSpace(text[1:end])
+			text = text[end+1:]
+			switch {
+			case isVueLiteral(placeholder):
+				writeText(placeholder[1 : len(placeholder)-1])
+			case choice && (placeholder == "count" || placeholder == "n"):
+				b.WriteString("#")
+			case placeholder == "":
+				return "", fmt.Errorf("%w: empty placeholder in %q", ErrInvalidVueI18n, name)
+			default:
+				capitalize = false
+				b.WriteString("{" + placeholder + "}")
+			}
+			continue
+		}
+
+		key, linkModifier, rest, ok := parseVueLink(text)
+		if !ok {
+			writeText("@")
+			text = text[1:]
+			continue
+		}
+		text = rest
+		if _, exists := r.messages[key]; !exists {
+			writeText("@:" + key)
+			continue
+		}
+		if linkModifier == "" {
+			linkModifier = modifier
+		}
+		linked, err := r.render(key, linkModifier, depth+1)
+		if err != nil {
+			return "", err
+		}
+		capitalize = false
+		b.WriteString(linked)
+	}
+	return b.String(), nil
+}
+
+// parseVueLink parses a linked message like `@:key`, `@:{'key'}` or `@.upper:key` at the start of the text.
+func parseVueLink(text string) (key, modifier, rest string, ok bool) {
+	rest = text[1:]
+	if strings.HasPrefix(rest, ".") {
+		end := strings.IndexByte(rest, ':')
+		if end < 0 {
+			return "", "", "", false
+		}
+		modifier, rest = rest[1:end], rest[end:]
+		if modifier != "upper" && modifier != "lower" && modifier != "capitalize" {
+			return "", "", "", false
+		}
+	}
+	if !strings.HasPrefix(rest, ":") {
+		return "", "", "", false
+	}
+	rest = rest[1:]
+
+	if strings.HasPrefix(rest, "{") {
+		end := strings.IndexByte(rest, '}')
+		if end < 0 || !isVueLiteral(strings.TrimSpace(rest[1:end])) {
+			return "", "", "", false
+		}
+		key = strings.TrimSpace(rest[1:end])
+		return key[1 : len(key)-1], modifier, rest[end+1:], true
+	}
+	end := strings.IndexFunc(rest, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && !strings.ContainsRune("_-./", r)
+	})
+	if end < 0 {
+		end = len(rest)
+	}
+	// A sentence may end right after the key.
+	key = strings.TrimRight(rest[:end], ".")
+	if key == "" {
+		return "", "", "", false
+	}
+	return key, modifier, rest[len(key):], true
+}
+
+// isVueLiteral reports whether a placeholder is a literal like `'@'`.
+func isVueLiteral(s string) bool {
+	return len(s) >= 2 && s[0] == '\'' && s[len(s)-1] == '\''
+}
+
+// splitVueChoices splits a message on the `|` that are outside of the placeholders.
+func splitVueChoices(text string) []string {
+	var choices []string
+	depth, start := 0, 0
+	for i := 0; i < len(text); i++ {
+		switch text[i] {
+		case '{':
+			depth++
+		case '}':
+			if depth > 0 {
+				depth--
+			}
+		case '|':
+			if depth == 0 {
+				choices = append(choices, text[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(choices, text[start:])
+}
@@ -0,0 +1,52 @@
+package i18n
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnmarshalVueI18n(t *testing.T) {
+	assert := assert.New(t)
+
+	b, err := os.ReadFile("test/vue/en.json")
+	assert.NoError(err)
+	var messages map[string]string
+	assert.NoError(UnmarshalVueI18n(b, &messages))
+	assert.Equal(map[string]string{
+		"message.the_world": "the world",
+		"message.dio":       "DIO:",
+		"message.linked":    "DIO: the world !!!!",
+		"message.quoted":    "DIO: THE WORLD",
+		"message.hello":     "hello {name}",
+		"message.list":      "{0} and {1}",
+		"message.capital":   "The world.",
+		"message.email":     "{account}@{domain}, not@link",
+		"car":               "{count, plural, =1 {car} other {cars}}",
+		"apple":             "{count, plural, =0 {no apples} =1 {one apple} other {# apples}}",
+		"banana":            "{count, plural, =0 {no bananas} =1 {# banana} other {# bananas}}",
+	}, messages)
+
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithUnmarshaler(UnmarshalVueI18n),
+	)
+	assert.NoError(bundle.LoadFiles("test/vue/en.json"))
+	localizer := bundle.NewLocalizer("en")
+	assert.Equal("hello Yami", localizer.Get("message.hello", Vars{"name": "Yami"}))
+	assert.Equal("a and b", localizer.Get("message.list", Vars{"0": "a", "1": "b"}))
+	assert.Equal("cars", localizer.Get("car", Vars{"count": 0}))
+	assert.Equal("car", localizer.Get("car", Vars{"count": 1}))
+	assert.Equal("no apples", localizer.Get("apple", Vars{"count": 0}))
+	assert.Equal("10 apples", localizer.Get("apple", Vars{"count": 10}))
+
+	for _, data := range []string{
+		`{`,
+		`{"a": "{name"}`,
+		`{"a": "{}"}`,
+	} {
+		assert.True(errors.Is(UnmarshalVueI18n([]byte(data), &messages), ErrInvalidVueI18n), data)
+	}
+}
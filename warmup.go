@@ -0,0 +1,34 @@
+package i18n
+
+import "errors"
+
+// Warmup compiles the messages of the locales (all the loaded locales if empty) whose names start
+// with one of the prefixes (all the messages if empty) if they're not compiled yet,
+// so the latency-critical paths can be primed at startup while the long tail stays lazy.
+// The compile errors of all the messages are joined.
+func (bundle *I18n) Warmup(locales []string, prefixes []string) error {
+	bundle.mu.RLock()
+	var translations []*parsedTranslation
+	if len(locales) == 0 {
+		for locale := range bundle.parsedTranslations {
+			locales = append(locales, locale)
+		}
+	}
+	for _, locale := range locales {
+		locale = bundle.getExactSupportedLocale(locale)
+		for name, trans := range bundle.parsedTranslations[locale] {
+			if len(prefixes) == 0 || hasAnyPrefix(name, prefixes) {
+				translations = append(translations, trans)
+			}
+		}
+	}
+	bundle.mu.RUnlock()
+
+	var errs []error
+	for _, trans := range translations {
+		if _, err := trans.compile(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,24 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWarmup(t *testing.T) {
+	assert := assert.New(t)
+
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "zh-Hans", "ja-JP", "ko-KR"),
+	)
+	assert.NoError(bundle.LoadMessages(testTranslations))
+
+	assert.NoError(bundle.Warmup([]string{"zh-Hans", "ja-JP"}, []string{"test_"}))
+	assert.NoError(bundle.Warmup(nil, nil))
+
+	for _, trans := range bundle.parsedTranslations["zh-Hans"] {
+		assert.True(trans.static || trans.format != nil)
+	}
+}
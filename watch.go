@@ -0,0 +1,268 @@
+package i18n
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"log/slog"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// WithWatch watches the files loaded by `LoadFiles`, `LoadGlob` and `LoadDirectory`, and the new files matching the glob patterns,
+// so the changed files are parsed again at runtime and replace the translations they provided at once: the names
+// removed from a file, or the names of a removed file, are removed from the catalogs, or take the translation of another
+// watched file of the locale, the later files in the loading order taking precedence like they do when loaded.
+// Translators can push updates to long-running servers without redeploys. The failures are logged
+// to the `WithLogger` logger. Call `Close` to stop watching.
+func WithWatch(enabled bool) func(*I18n) {
+	return func(bundle *I18n) {
+		bundle.watch = enabled
+	}
+}
+
+// Close stops watching the files and refreshing the remote files, see `WithWatch` and `WithRefreshInterval`,
+// and flushes the missing sinks that buffer their entries like `NewMissingFileSink`.
+func (bundle *I18n) Close() error {
+	bundle.mu.Lock()
+	w, r := bundle.watcher, bundle.refresher
+	bundle.watcher, bundle.refresher = nil, nil
+	bundle.mu.Unlock()
+
+	if r != nil {
+		r.close()
+	}
+	var errs []error
+	if w != nil {
+		errs = append(errs, w.close())
+	}
+	for _, sink := range bundle.missingSinks {
+		if f, ok := sink.(interface{ Flush() error }); ok {
+			errs = append(errs, f.Flush())
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// fileWatcher reloads the watched files of a bundle.
+type fileWatcher struct {
+	bundle  *I18n
+	watcher *fsnotify.Watcher
+	done    chan struct{}
+
+	mu    sync.Mutex
+	files map[string]translationFile
+	// order are the paths of the files in the order they were loaded, the later files take precedence.
+	order []string
+	// messages are the translations last loaded from the files, by path.
+	messages map[string]map[string]string
+	patterns []string
+	dirs     map[string]bool
+}
+
+// watchFiles starts watching the files and the glob patterns if the watch is enabled.
+// The messages are the translations loaded from the files, in the same order.
+func (bundle *I18n) watchFiles(files []translationFile, messages []map[string]string, patterns []string) error {
+	if !bundle.watch {
+		return nil
+	}
+
+	bundle.mu.Lock()
+	w := bundle.watcher
+	if w == nil {
+		watcher, err := fsnotify.NewWatcher()
+		if err != nil {
+			bundle.mu.Unlock()
+			return err
+		}
+		w = &fileWatcher{
+			bundle:   bundle,
+			watcher:  watcher,
+			done:     make(chan struct{}),
+			files:    make(map[string]translationFile),
+			messages: make(map[string]map[string]string),
+			dirs:     make(map[string]bool),
+		}
+		bundle.watcher = w
+		go w.run()
+	}
+	bundle.mu.Unlock()
+
+	return w.add(files, messages, patterns)
+}
+
+// add watches the directories of the files and the patterns.
+func (w *fileWatcher) add(files []translationFile, messages []map[string]string, patterns []string) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	var dirs []string
+	for i, file := range files {
+		file.path = filepath.Clean(file.path)
+		if _, ok := w.files[file.path]; ok {
+			w.order = slices.DeleteFunc(w.order, func(path string) bool { return path == file.path })
+		}
+		w.files[file.path] = file
+		w.order = append(w.order, file.path)
+		w.messages[file.path] = messages[i]
+		dirs = append(dirs, filepath.Dir(file.path))
+	}
+	for _, pattern := range patterns {
+		w.patterns = append(w.patterns, filepath.Clean(pattern))
+		dirs = append(dirs, filepath.Dir(pattern))
+	}
+	for _, dir := range dirs {
+		// The directories are watched rather than the files, so the files replaced by renames are still watched.
+		if w.dirs[dir] {
+			continue
+		}
+		if err := w.watcher.Add(dir); err != nil {
+			// The directory of a pattern like `locales/*/*.json` can't be watched, the matched files are.
+			if _, statErr := os.Stat(dir); statErr != nil {
+				continue
+			}
+			return err
+		}
+		w.dirs[dir] = true
+	}
+	return nil
+}
+
+// watches returns the watched file of a changed path, false if it must not be reloaded.
+func (w *fileWatcher) watches(path string) (translationFile, bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if file, ok := w.files[path]; ok {
+		return file, true
+	}
+	for _, pattern := range w.patterns {
+		if ok, _ := filepath.Match(pattern, path); ok {
+			file := translationFile{path: path, locale: nameInsenstive(path)}
+			w.files[path] = file
+			w.order = append(w.order, path)
+			return file, true
+		}
+	}
+	return translationFile{}, false
+}
+
+// run reloads the changed files until the watcher is closed.
+func (w *fileWatcher) run() {
+	defer close(w.done)
+	for {
+		select {
+		case event, ok := <-w.watcher.Events:
+			if !ok {
+				return
+			}
+			if !event.Has(fsnotify.Write) && !event.Has(fsnotify.Create) &&
+				!event.Has(fsnotify.Remove) && !event.Has(fsnotify.Rename) {
+				continue
+			}
+			file, ok := w.watches(filepath.Clean(event.Name))
+			if !ok {
+				continue
+			}
+			if err := w.reload(file); err != nil {
+				w.bundle.logLoadError(file.path, err)
+			}
+		case err, ok := <-w.watcher.Errors:
+			if !ok {
+				return
+			}
+			w.bundle.logLoadError("", err)
+		}
+	}
+}
+
+// close stops watching and waits for the running reload.
+func (w *fileWatcher) close() error {
+	err := w.watcher.Close()
+	<-w.done
+	return err
+}
+
+// reload replaces the translations of a changed file in the catalogs, the removed or renamed files
+// no longer provide any.
+func (w *fileWatcher) reload(file translationFile) error {
+	var trans map[string]string
+	b, err := os.ReadFile(file.path) //nolint:gosec
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+	case err != nil:
+		return err
+	case len(b) == 0:
+		// Editors truncate the files before writing them, the next write event brings the content.
+		return nil
+	default:
+		if trans, err = file.unmarshal(w.bundle, b); err != nil {
+			return err
+		}
+	}
+
+	messages, removed := w.changes(file, trans)
+	err = w.bundle.replaceMessages(file.locale, messages, removed)
+	if errors.Is(err, ErrInvalidLocale) {
+		// The files of the unsupported locales are ignored like `LoadFiles` does.
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	w.mu.Lock()
+	w.messages[file.path] = trans
+	w.mu.Unlock()
+	return nil
+}
+
+// changes returns the translations to load for the new translations of a file, and the names to remove.
+// The names the file provided or provides are merged again from the watched files of the locale in their order,
+// so a later file keeps its precedence, and the names no file provides are removed.
+func (w *fileWatcher) changes(file translationFile, trans map[string]string) (map[string]string, []string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	merged := make(map[string]string)
+	for _, path := range w.order {
+		if !strings.EqualFold(w.files[path].locale, file.locale) {
+			continue
+		}
+		provided := w.messages[path]
+		if path == file.path {
+			provided = trans
+		}
+		maps.Copy(merged, provided)
+	}
+
+	messages := make(map[string]string, len(trans))
+	var removed []string
+	for _, provided := range []map[string]string{trans, w.messages[file.path]} {
+		for name := range provided {
+			if text, ok := merged[name]; ok {
+				messages[name] = text
+			} else {
+				removed = append(removed, name)
+			}
+		}
+	}
+	return messages, removed
+}
+
+// logLoadError logs a failed load of a source in the background, like a file, a remote store or a library.
+func (bundle *I18n) logLoadError(source string, err error) {
+	if bundle.logger == nil {
+		return
+	}
+	bundle.logger.LogAttrs(context.Background(), slog.LevelError, "i18n: failed to load the translations",
+		slog.String("source", source),
+		slog.String("error", err.Error()),
+	)
+}
@@ -0,0 +1,147 @@
+package i18n
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithWatch(t *testing.T) {
+	assert := assert.New(t)
+
+	dir := t.TempDir()
+	en := filepath.Join(dir, "en.json")
+	assert.NoError(os.WriteFile(en, []byte(`{"hello": "Hello"}`), 0o600))
+
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithLocales("en", "zh-Hans"),
+		WithWatch(true),
+	)
+	t.Cleanup(func() { assert.NoError(bundle.Close()) })
+	assert.NoError(bundle.LoadGlob(filepath.Join(dir, "*.json")))
+	assert.Equal("Hello", bundle.NewLocalizer("en").Get("hello"))
+
+	assert.NoError(os.WriteFile(en, []byte(`{"hello": "Hello, {name}"}`), 0o600))
+	assert.Eventually(func() bool {
+		return bundle.NewLocalizer("en").Get("hello", Vars{"name": "Yami"}) == "Hello, Yami"
+	}, 5*time.Second, 10*time.Millisecond)
+
+	assert.NoError(os.WriteFile(filepath.Join(dir, "zh-Hans.json"), []byte(`{"hello": "你好"}`), 0o600))
+	assert.Eventually(func() bool {
+		return bundle.NewLocalizer("zh-Hans").Get("hello") == "你好"
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// Invalid files are ignored.
+	assert.NoError(os.WriteFile(en, []byte(`{"hello": "{name"}`), 0o600))
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal("Hello, Yami", bundle.NewLocalizer("en").Get("hello", Vars{"name": "Yami"}))
+}
+
+func TestWatchDirectory(t *testing.T) {
+	assert := assert.New(t)
+
+	dir := t.TempDir()
+	errors := filepath.Join(dir, "zh-Hans", "errors.json")
+	assert.NoError(os.Mkdir(filepath.Dir(errors), 0o700))
+	assert.NoError(os.WriteFile(errors, []byte(`{"title": "错误"}`), 0o600))
+
+	bundle := NewBundle(
+		WithDefaultLocale("zh-Hans"),
+		WithWatch(true),
+	)
+	t.Cleanup(func() { assert.NoError(bundle.Close()) })
+	assert.NoError(bundle.LoadDirectory(dir, true))
+	assert.Equal("错误", bundle.NewLocalizer("zh-Hans").Get("errors.title"))
+
+	assert.NoError(os.WriteFile(errors, []byte(`{"title": "出错了"}`), 0o600))
+	assert.Eventually(func() bool {
+		return bundle.NewLocalizer("zh-Hans").Get("errors.title") == "出错了"
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestWatchRemovedNames(t *testing.T) {
+	assert := assert.New(t)
+
+	dir := t.TempDir()
+	assert.NoError(os.Mkdir(filepath.Join(dir, "en"), 0o700))
+	common := filepath.Join(dir, "en", "common.json")
+	extra := filepath.Join(dir, "en", "extra.json")
+	assert.NoError(os.WriteFile(common, []byte(`{"hello": "Hello", "bye": "Bye", "ok": "OK"}`), 0o600))
+	assert.NoError(os.WriteFile(extra, []byte(`{"ok": "Okay"}`), 0o600))
+
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithWatch(true),
+	)
+	t.Cleanup(func() { assert.NoError(bundle.Close()) })
+	assert.NoError(bundle.LoadDirectory(dir, false))
+	assert.Equal("Bye", bundle.NewLocalizer("en").Get("bye"))
+
+	// `bye` is renamed to `goodbye`, `ok` is still provided by the other file.
+	assert.NoError(os.WriteFile(common, []byte(`{"hello": "Hello", "goodbye": "Bye"}`), 0o600))
+	assert.Eventually(func() bool {
+		return bundle.NewLocalizer("en").Get("goodbye") == "Bye"
+	}, 5*time.Second, 10*time.Millisecond)
+
+	localizer := bundle.NewLocalizer("en")
+	_, err := localizer.GetE("bye")
+	assert.ErrorIs(err, ErrMissingMessage)
+	assert.Equal("Okay", localizer.Get("ok"))
+	assert.Equal("Hello", localizer.Get("hello"))
+}
+
+func TestWatchFileOrder(t *testing.T) {
+	assert := assert.New(t)
+
+	dir := t.TempDir()
+	first := filepath.Join(dir, "first", "en.json")
+	second := filepath.Join(dir, "second", "en.json")
+	assert.NoError(os.Mkdir(filepath.Dir(first), 0o700))
+	assert.NoError(os.Mkdir(filepath.Dir(second), 0o700))
+	assert.NoError(os.WriteFile(first, []byte(`{"title": "First"}`), 0o600))
+	assert.NoError(os.WriteFile(second, []byte(`{"title": "Second"}`), 0o600))
+
+	bundle := NewBundle(
+		WithDefaultLocale("en"),
+		WithWatch(true),
+	)
+	t.Cleanup(func() { assert.NoError(bundle.Close()) })
+	assert.NoError(bundle.LoadFiles(first, second))
+	assert.Equal("Second", bundle.NewLocalizer("en").Get("title"))
+
+	// The later file keeps its precedence when the first one changes.
+	assert.NoError(os.WriteFile(first, []byte(`{"title": "First again", "body": "Body"}`), 0o600))
+	assert.Eventually(func() bool {
+		return bundle.NewLocalizer("en").Get("body") == "Body"
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal("Second", bundle.NewLocalizer("en").Get("title"))
+
+	// The names of a removed file take the translations of the other file.
+	assert.NoError(os.Remove(second))
+	assert.Eventually(func() bool {
+		return bundle.NewLocalizer("en").Get("title") == "First again"
+	}, 5*time.Second, 10*time.Millisecond)
+
+	assert.NoError(os.Remove(first))
+	assert.Eventually(func() bool {
+		_, err := bundle.NewLocalizer("en").GetE("body")
+		return errors.Is(err, ErrMissingMessage)
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestWithoutWatch(t *testing.T) {
+	assert := assert.New(t)
+
+	bundle := NewBundle(
+		WithDefaultLocale("zh-Hans"),
+		WithLocales("zh-Hans"),
+	)
+	assert.NoError(bundle.LoadFiles("test/zh-Hans.json"))
+	assert.Nil(bundle.watcher)
+	assert.NoError(bundle.Close())
+}
@@ -0,0 +1,87 @@
+package i18n
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+// WeekData is the week of the region of a locale in CLDR, for the calendar widgets and the scheduling code.
+type WeekData struct {
+	// FirstDay is the first day of the week in the calendars, e.g. Sunday in the US and Monday in France.
+	FirstDay time.Weekday
+	// Weekend are the days of the weekend from its first day, e.g. Friday and Saturday in Egypt.
+	Weekend []time.Weekday
+}
+
+// IsWeekend indicates whether a day is a day of the weekend.
+func (w WeekData) IsWeekend(day time.Weekday) bool {
+	return slices.Contains(w.Weekend, day)
+}
+
+// firstDayRegions are the regions whose week doesn't start on Monday, by first day.
+var firstDayRegions = map[time.Weekday]string{
+	time.Friday:   "MV",
+	time.Saturday: "AF BH DJ DZ EG IQ IR JO KW LY OM QA SD SY",
+	time.Sunday: "AG AS BD BR BS BT BW BZ CA CO DM DO ET GT GU HK HN ID IL IN JM JP KE KH KR LA MH MM MO MT MX " +
+		"MZ NI NP PA PE PH PK PR PT PY SA SG SV TH TT TW UM US VE VI WS YE ZA ZW",
+}
+
+// weekendRegions are the regions whose weekend isn't Saturday and Sunday.
+var weekendRegions = []struct {
+	weekend []time.Weekday
+	regions string
+}{
+	{[]time.Weekday{time.Thursday, time.Friday}, "AF"},
+	{[]time.Weekday{time.Friday}, "IR"},
+	{[]time.Weekday{time.Friday, time.Saturday}, "BH DZ EG IL IQ JO KW LY OM QA SA SD SY YE"},
+	{[]time.Weekday{time.Sunday}, "IN UG"},
+}
+
+// firstDayKeys are the values of the `fw` key of the locales, e.g. `en-US-u-fw-mon`.
+var firstDayKeys = []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+
+// WeekData returns the first day of the week and the weekend of the region of a locale, the likely region if
+// it has none, e.g. Sunday and Saturday and Sunday for `en` (US). The region is the one of the locale itself,
+// not of the locale selected for the messages, and the `fw` key overrides the first day, like `en-US-u-fw-mon`.
+// The default locale is used if the locale is empty.
+func (bundle *I18n) WeekData(locale string) WeekData {
+	tag := language.Make(locale)
+	if locale == "" {
+		bundle.mu.RLock()
+		tag = bundle.defaultLanguage
+		bundle.mu.RUnlock()
+	}
+	return weekData(tag)
+}
+
+// weekData returns the week of the region of a tag.
+func weekData(tag language.Tag) WeekData {
+	region, _ := tag.Region()
+	code := region.String()
+
+	w := WeekData{FirstDay: time.Monday, Weekend: []time.Weekday{time.Saturday, time.Sunday}}
+	for day, regions := range firstDayRegions {
+		if hasRegion(regions, code) {
+			w.FirstDay = day
+			break
+		}
+	}
+	for _, r := range weekendRegions {
+		if hasRegion(r.regions, code) {
+			w.Weekend = slices.Clone(r.weekend)
+			break
+		}
+	}
+	if i := slices.Index(firstDayKeys, tag.TypeForKey("fw")); i >= 0 {
+		w.FirstDay = time.Weekday(i)
+	}
+	return w
+}
+
+// hasRegion indicates whether a list of region codes separated by spaces contains a code.
+func hasRegion(regions, code string) bool {
+	return slices.Contains(strings.Fields(regions), code)
+}
@@ -0,0 +1,32 @@
+package i18n
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWeekData(t *testing.T) {
+	assert := assert.New(t)
+
+	bundle := newTestParseBundle()
+	weekend := []time.Weekday{time.Saturday, time.Sunday}
+
+	assert.Equal(WeekData{FirstDay: time.Sunday, Weekend: weekend}, bundle.WeekData("en"))
+	assert.Equal(WeekData{FirstDay: time.Monday, Weekend: weekend}, bundle.WeekData("en-GB"))
+	// The region of the locale is used even if the messages are the ones of `en`.
+	assert.Equal(WeekData{FirstDay: time.Monday, Weekend: weekend}, bundle.WeekData("en-AU"))
+	assert.Equal(WeekData{FirstDay: time.Monday, Weekend: weekend}, bundle.WeekData("fr"))
+	assert.Equal(WeekData{FirstDay: time.Sunday, Weekend: weekend}, bundle.WeekData("ja"))
+	assert.Equal(WeekData{FirstDay: time.Saturday, Weekend: []time.Weekday{time.Friday, time.Saturday}}, bundle.WeekData("ar"))
+	assert.Equal(WeekData{FirstDay: time.Sunday, Weekend: []time.Weekday{time.Friday, time.Saturday}}, bundle.WeekData("ar-SA"))
+	assert.Equal(WeekData{FirstDay: time.Saturday, Weekend: []time.Weekday{time.Friday}}, bundle.WeekData("fa"))
+	assert.Equal(WeekData{FirstDay: time.Sunday, Weekend: []time.Weekday{time.Sunday}}, bundle.WeekData("hi-IN"))
+	assert.Equal(WeekData{FirstDay: time.Monday, Weekend: weekend}, bundle.WeekData("en-US-u-fw-mon"))
+	// The default locale is `en`.
+	assert.Equal(WeekData{FirstDay: time.Sunday, Weekend: weekend}, bundle.WeekData(""))
+
+	assert.True(bundle.WeekData("he").IsWeekend(time.Friday))
+	assert.False(bundle.WeekData("de").IsWeekend(time.Friday))
+}